@@ -0,0 +1,18 @@
+package api
+
+// BatchRequest is the body of POST /api/v1/batch
+type BatchRequest struct {
+	Problems []BatchProblem `json:"problems" binding:"required,min=1,dive"`
+	// Workers is the number of problems solved at the same time, the server
+	// caps it
+	Workers int `json:"workers,omitempty" binding:"omitempty,min=1"`
+}
+
+// BatchProblem is a problem of a batch, either in the text format or of the
+// typed model
+type BatchProblem struct {
+	// Name defaults to the position of the problem, from 1
+	Name          string   `json:"name,omitempty"`
+	ProblemString string   `json:"problemString,omitempty" binding:"required_without=Model,excluded_with=Model"`
+	Model         *Problem `json:"model,omitempty"`
+}
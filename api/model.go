@@ -0,0 +1,309 @@
+// Package api holds the typed JSON model of the versioned HTTP API
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"pnle/lp"
+	"time"
+)
+
+const (
+	VariableInteger    = "integer"
+	VariableContinuous = "continuous"
+	VariableBinary     = "binary"
+)
+
+// Problem is the body of POST /api/v1/solve
+type Problem struct {
+	Name        string       `json:"name,omitempty"`
+	Variables   []Variable   `json:"variables" binding:"required,min=1,dive"`
+	Objective   Objective    `json:"objective" binding:"required"`
+	Constraints []Constraint `json:"constraints" binding:"dive"`
+	Options     *Options     `json:"options,omitempty"`
+}
+
+type Variable struct {
+	Name string `json:"name" binding:"required"`
+	// Type is integer when it is empty
+	Type  string   `json:"type,omitempty" binding:"omitempty,oneof=integer continuous binary"`
+	Lower *float64 `json:"lower,omitempty" binding:"omitempty,gte=0"`
+	Upper *float64 `json:"upper,omitempty" binding:"omitempty,gte=0"`
+}
+
+type Objective struct {
+	Sense string `json:"sense" binding:"required,oneof=max min"`
+	// Coefficients are keyed by variable name, missing variables have a zero coefficient
+	Coefficients map[string]float64 `json:"coefficients" binding:"required"`
+}
+
+type Constraint struct {
+	// Name defaults to c1, c2... after the position of the constraint
+	Name         string             `json:"name,omitempty"`
+	Coefficients map[string]float64 `json:"coefficients" binding:"required"`
+	Type         string             `json:"type" binding:"required,oneof=<= >= ="`
+	Rhs          float64            `json:"rhs"`
+}
+
+type Options struct {
+	Workers          int     `json:"workers,omitempty" binding:"omitempty,min=1,max=256"`
+	Deterministic    bool    `json:"deterministic,omitempty"`
+	TimeLimitSeconds float64 `json:"timeLimitSeconds,omitempty" binding:"omitempty,gt=0"`
+	NodeLimit        int     `json:"nodeLimit,omitempty" binding:"omitempty,min=1"`
+	SolutionLimit    int     `json:"solutionLimit,omitempty" binding:"omitempty,min=1"`
+	RelativeGap      float64 `json:"relativeGap,omitempty" binding:"omitempty,gte=0,lte=1"`
+	// DisableHeuristics turns the primal heuristics off
+	DisableHeuristics bool `json:"disableHeuristics,omitempty"`
+}
+
+// Result is the response of POST /api/v1/solve
+type Result struct {
+	Name      string         `json:"name,omitempty"`
+	Status    lp.SolveStatus `json:"status"`
+	Objective *float64       `json:"objective,omitempty"`
+	// Values are keyed by variable name
+	Values map[string]float64 `json:"values,omitempty"`
+	// Duals are the shadow prices of the constraints in the LP relaxation, keyed
+	// by constraint name
+	Duals      map[string]float64 `json:"duals,omitempty"`
+	Statistics Statistics         `json:"statistics"`
+}
+
+type Statistics struct {
+	Nodes            int     `json:"nodes"`
+	DualBound        float64 `json:"dualBound"`
+	AbsoluteGap      float64 `json:"absoluteGap"`
+	RelativeGap      float64 `json:"relativeGap"`
+	SolveTimeSeconds float64 `json:"solveTimeSeconds"`
+}
+
+// ConstraintName returns the name of the i-th constraint
+func (p *Problem) ConstraintName(i int) string {
+	if p.Constraints[i].Name != "" {
+		return p.Constraints[i].Name
+	}
+	return fmt.Sprintf("c%d", i+1)
+}
+
+// Validate checks what the binding tags cannot, that is the references to
+// variable names and the consistency of the bounds
+func (p *Problem) Validate() error {
+	indexes := make(map[string]int, len(p.Variables))
+	for i, variable := range p.Variables {
+		if _, ok := indexes[variable.Name]; ok {
+			return fmt.Errorf("variable %q is declared twice", variable.Name)
+		}
+		indexes[variable.Name] = i
+		if variable.Lower != nil && variable.Upper != nil && *variable.Lower > *variable.Upper {
+			return fmt.Errorf("variable %q has a lower bound above its upper bound", variable.Name)
+		}
+	}
+	for name := range p.Objective.Coefficients {
+		if _, ok := indexes[name]; !ok {
+			return fmt.Errorf("the objective uses the unknown variable %q", name)
+		}
+	}
+	names := make(map[string]bool, len(p.Constraints))
+	for i, constraint := range p.Constraints {
+		name := p.ConstraintName(i)
+		if names[name] {
+			return fmt.Errorf("constraint %q is declared twice", name)
+		}
+		names[name] = true
+		for variable := range constraint.Coefficients {
+			if _, ok := indexes[variable]; !ok {
+				return fmt.Errorf("constraint %q uses the unknown variable %q", name, variable)
+			}
+		}
+	}
+	return nil
+}
+
+// NewProblem builds the model of a problem of the text format, its variables
+// are named x1, x2... and its constraints c1, c2...
+func NewProblem(problem *lp.LinearProblem) *Problem {
+	p := &Problem{Objective: Objective{Sense: "min", Coefficients: make(map[string]float64)}}
+	if problem.IsMaximization {
+		p.Objective.Sense = "max"
+	}
+	names := make([]string, len(problem.ObjectiveFunction))
+	for j, coefficient := range problem.ObjectiveFunction {
+		names[j] = fmt.Sprintf("x%d", j+1)
+		variable := Variable{Name: names[j]}
+		if j < len(problem.Continuous) && problem.Continuous[j] {
+			variable.Type = VariableContinuous
+		}
+		p.Variables = append(p.Variables, variable)
+		if coefficient != 0 {
+			p.Objective.Coefficients[names[j]] = coefficient
+		}
+	}
+	for i, row := range problem.Constraints {
+		constraint := Constraint{Coefficients: make(map[string]float64), Type: problem.ConstraintTypes[i], Rhs: problem.Rhs[i]}
+		for j, coefficient := range row {
+			if coefficient != 0 {
+				constraint.Coefficients[names[j]] = coefficient
+			}
+		}
+		p.Constraints = append(p.Constraints, constraint)
+	}
+	return p
+}
+
+// IntegerProblem builds the problem to solve, the variable bounds are added as
+// constraints after the constraints of p
+func (p *Problem) IntegerProblem() *lp.IntegerLineaProblem {
+	n := len(p.Variables)
+	problem := &lp.LinearProblem{
+		ObjectiveFunction: make([]float64, n),
+		IsMaximization:    p.Objective.Sense == "max",
+		Continuous:        make([]bool, n),
+	}
+	for j, variable := range p.Variables {
+		problem.ObjectiveFunction[j] = p.Objective.Coefficients[variable.Name]
+		problem.Continuous[j] = variable.Type == VariableContinuous
+	}
+	addConstraint := func(row []float64, constraintType string, rhs float64) {
+		problem.Constraints = append(problem.Constraints, row)
+		problem.ConstraintTypes = append(problem.ConstraintTypes, constraintType)
+		problem.Rhs = append(problem.Rhs, rhs)
+	}
+	for _, constraint := range p.Constraints {
+		row := make([]float64, n)
+		for j, variable := range p.Variables {
+			row[j] = constraint.Coefficients[variable.Name]
+		}
+		addConstraint(row, constraint.Type, constraint.Rhs)
+	}
+	for j, variable := range p.Variables {
+		lower, upper := variable.Lower, variable.Upper
+		if variable.Type == VariableBinary && upper == nil {
+			one := 1.0
+			upper = &one
+		}
+		if lower != nil && *lower > 0 {
+			row := make([]float64, n)
+			row[j] = 1
+			addConstraint(row, ">=", *lower)
+		}
+		if upper != nil {
+			row := make([]float64, n)
+			row[j] = 1
+			addConstraint(row, "<=", *upper)
+		}
+	}
+	// the last rhs holds the objective value
+	problem.Rhs = append(problem.Rhs, 0)
+	problem.InitialConstraintLength = len(problem.Constraints)
+	problem.InitialObjectiveLength = n
+	return &lp.IntegerLineaProblem{InitialProblem: *problem}
+}
+
+// Apply returns options updated with the solver options of the request, the
+// workers, time and node limits of the request cannot exceed the ones of options
+func (o *Options) Apply(options lp.SolveOptions) lp.SolveOptions {
+	if o == nil {
+		return options
+	}
+	if o.Workers > 0 && o.Workers < options.Workers {
+		options.Workers = o.Workers
+	}
+	options.Deterministic = o.Deterministic
+	timeLimit := time.Duration(o.TimeLimitSeconds * float64(time.Second))
+	if timeLimit > 0 && (options.TimeLimit == 0 || timeLimit < options.TimeLimit) {
+		options.TimeLimit = timeLimit
+	}
+	if o.NodeLimit > 0 && (options.NodeLimit == 0 || o.NodeLimit < options.NodeLimit) {
+		options.NodeLimit = o.NodeLimit
+	}
+	options.SolutionLimit = o.SolutionLimit
+	if o.RelativeGap > 0 {
+		options.RelativeGapTolerance = o.RelativeGap
+	}
+	if o.DisableHeuristics {
+		options.Heuristics = nil
+	}
+	return options
+}
+
+// Solve solves p with options updated by the options of p. When ctx is done
+// before the end it returns the result so far, with StatusCanceled, along with
+// the context error
+func (p *Problem) Solve(ctx context.Context, options lp.SolveOptions) (*Result, error) {
+	problem := p.IntegerProblem()
+	start := time.Now()
+	if _, err := problem.SolveContext(ctx, p.Options.Apply(options)); err != nil {
+		return NewResult(p, problem, nil, time.Since(start)), err
+	}
+	solveTime := time.Since(start)
+	duals, err := problem.InitialProblem.Duals(ctx)
+	if err != nil {
+		return NewResult(p, problem, nil, solveTime), err
+	}
+	return NewResult(p, problem, duals, solveTime), nil
+}
+
+// SolveRelaxation solves the linear relaxation of p with the simplex, ignoring
+// the integrality of the variables. The status of the result is optimal,
+// infeasible, unbounded or canceled, along with the context error
+func (p *Problem) SolveRelaxation(ctx context.Context, options lp.SolveOptions) (*Result, error) {
+	problem := p.IntegerProblem()
+	start := time.Now()
+	solution, err := problem.InitialProblem.SolveContext(ctx, p.Options.Apply(options))
+	solveTime := time.Since(start)
+	switch {
+	case err != nil:
+		problem.Status = lp.StatusCanceled
+		return NewResult(p, problem, nil, solveTime), err
+	case solution != nil:
+		problem.Status = lp.StatusOptimal
+		problem.HasSolution = true
+		problem.OptimalVariableValues = solution.OptimalVariableValues
+		problem.OptimalObjectiveFunctionValue = solution.OptimalObjectiveFunctionValue
+		problem.DualBound = problem.OptimalObjectiveFunctionValue
+	case problem.InitialProblem.IsUnbounded:
+		problem.Status = lp.StatusUnbounded
+	default:
+		problem.Status = lp.StatusInfeasible
+	}
+	if !problem.HasSolution {
+		return NewResult(p, problem, nil, solveTime), nil
+	}
+	duals, err := problem.InitialProblem.Duals(ctx)
+	if err != nil {
+		return NewResult(p, problem, nil, solveTime), err
+	}
+	return NewResult(p, problem, duals, solveTime), nil
+}
+
+// NewResult builds the result of a solved problem, duals may be nil
+func NewResult(p *Problem, problem *lp.IntegerLineaProblem, duals []float64, solveTime time.Duration) *Result {
+	result := &Result{
+		Name:   p.Name,
+		Status: problem.Status,
+		Statistics: Statistics{
+			Nodes:            problem.Nodes,
+			DualBound:        problem.DualBound,
+			AbsoluteGap:      problem.AbsoluteGap,
+			RelativeGap:      problem.RelativeGap,
+			SolveTimeSeconds: solveTime.Seconds(),
+		},
+	}
+	if problem.HasSolution {
+		objective := problem.OptimalObjectiveFunctionValue
+		result.Objective = &objective
+		result.Values = make(map[string]float64, len(p.Variables))
+		for j, variable := range p.Variables {
+			result.Values[variable.Name] = problem.OptimalVariableValues[j]
+		}
+	}
+	if duals != nil {
+		result.Duals = make(map[string]float64, len(p.Constraints))
+		for i := range p.Constraints {
+			// avoid reporting -0 and rounding noise of the simplex
+			result.Duals[p.ConstraintName(i)] = math.Round(duals[i]*1e9)/1e9 + 0
+		}
+	}
+	return result
+}
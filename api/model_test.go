@@ -0,0 +1,28 @@
+package api
+
+import (
+	"pnle/lp"
+	"testing"
+	"time"
+)
+
+func TestOptionsApply(t *testing.T) {
+	server := lp.SolveOptions{Workers: 4, TimeLimit: 30 * time.Second, NodeLimit: 1000}
+	tests := []struct {
+		name    string
+		options Options
+		want    lp.SolveOptions
+	}{
+		{"lower", Options{Workers: 2, TimeLimitSeconds: 5, NodeLimit: 10},
+			lp.SolveOptions{Workers: 2, TimeLimit: 5 * time.Second, NodeLimit: 10}},
+		{"higher", Options{Workers: 256, TimeLimitSeconds: 60, NodeLimit: 100000}, server},
+		{"unset", Options{}, server},
+	}
+	for _, test := range tests {
+		got := test.options.Apply(server)
+		if got.Workers != test.want.Workers || got.TimeLimit != test.want.TimeLimit || got.NodeLimit != test.want.NodeLimit {
+			t.Errorf("%s: Apply() = %d workers, %v, %d nodes, want %d workers, %v, %d nodes", test.name,
+				got.Workers, got.TimeLimit, got.NodeLimit, test.want.Workers, test.want.TimeLimit, test.want.NodeLimit)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"pnle/graphical"
+	"pnle/lp"
+)
+
+// TextProblem is the body of POST /solve and POST /solve/stream, a problem in
+// the text format of lp.ParseProblem
+type TextProblem struct {
+	ProblemString string `json:"problemString" binding:"required"`
+}
+
+// SolveResponse is the response of POST /solve and the last event of
+// POST /solve/stream
+type SolveResponse struct {
+	Status lp.SolveStatus `json:"status"`
+	// Error tells why there is no solution, the fields of the solution are not set
+	// when there is one
+	Error                 string                 `json:"error,omitempty"`
+	SolutionProblemString string                 `json:"solutionProblemString,omitempty"`
+	SolutionString        string                 `json:"solutionString,omitempty"`
+	Tableaux              []*lp.SimplexTableau   `json:"tableaux,omitempty"`
+	DualBound             float64                `json:"dualBound"`
+	AbsoluteGap           float64                `json:"absoluteGap"`
+	RelativeGap           float64                `json:"relativeGap"`
+	Nodes                 int                    `json:"nodes"`
+	Tree                  *lp.BranchAndBoundTree `json:"tree"`
+	TreeDot               string                 `json:"treeDot"`
+	// NodeTableaux are the simplex tableaux of each node, keyed by node ID
+	NodeTableaux  map[int][]*lp.SimplexTableau `json:"nodeTableaux"`
+	IncumbentNode int                          `json:"incumbentNode,omitempty"`
+	// IncumbentSource is "branch and bound" or the name of the heuristic that found
+	// the solution, whose tableaux are then those of the root problem with the
+	// integer variables fixed to their values
+	IncumbentSource string `json:"incumbentSource,omitempty"`
+	// NodeTableauxTruncated tells that NodeTableaux only has the nodes with the
+	// lowest IDs and the incumbent node, the server answering a limited number
+	NodeTableauxTruncated bool `json:"nodeTableauxTruncated,omitempty"`
+	// ProblemHTML and SolutionHTML are the problem and the values of the solution
+	// written in HTML, which the web page shows without rendering any math
+	ProblemHTML  string `json:"problemHtml,omitempty"`
+	SolutionHTML string `json:"solutionHtml,omitempty"`
+	// Graphical is only set for the problems with two variables
+	Graphical *Graphical `json:"graphical"`
+	// HistoryID is the ID of the problem in the history, when the server keeps one
+	HistoryID string `json:"historyId,omitempty"`
+}
+
+// Graphical is the solution of a two variable problem by the graphical method
+type Graphical struct {
+	Solution *graphical.Solution `json:"solution"`
+	SVG      string              `json:"svg"`
+}
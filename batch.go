@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"pnle/api"
+	"pnle/batch"
+	"pnle/internal/transport"
+	"pnle/lp"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const mimeCSV = "text/csv"
+
+// solveBatch solves the problems of a batch on -batch-workers goroutines at
+// most and answers with the report, as CSV when ?format=csv is given or the
+// client accepts text/csv rather than JSON. Each goroutine takes one of the
+// free slots of solves, the batch is refused when there is none
+func solveBatch(c *config, solves *transport.Semaphore) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var request api.BatchRequest
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			if bodyTooLarge(ctx, err) {
+				return
+			}
+			parseErrors.Inc("json")
+			ctx.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		if c.MaxBatch > 0 && len(request.Problems) > c.MaxBatch {
+			rejectedRequests.Inc("batch_size")
+			ctx.JSON(413, gin.H{"error": fmt.Sprintf("the batch has %d problems, the limit is %d", len(request.Problems), c.MaxBatch)})
+			return
+		}
+		instances := make([]batch.Instance, len(request.Problems))
+		for i, p := range request.Problems {
+			problem, format, err := batchProblem(p)
+			if err != nil {
+				parseErrors.Inc(format)
+				ctx.JSON(400, gin.H{"error": fmt.Sprintf("problem %d: %v", i+1, err)})
+				return
+			}
+			if !checkProblemSize(ctx, c, len(problem.Variables), len(problem.Constraints)) {
+				return
+			}
+			instances[i] = batch.Instance{Name: p.Name, Problem: problem}
+			if p.Name == "" {
+				instances[i].Name = strconv.Itoa(i + 1)
+			}
+		}
+		workers := min(c.BatchWorkers, len(instances))
+		if request.Workers > 0 && request.Workers < workers {
+			workers = request.Workers
+		}
+		workers = solves.TryAcquire(workers)
+		if workers == 0 {
+			rejectSolve(ctx)
+			return
+		}
+		defer solves.Release(workers)
+		logger := requestLogger(ctx)
+		report := batch.Run(ctx.Request.Context(), instances, workers, func(solveCtx context.Context, problem *api.Problem) (*api.Result, error) {
+			options := c.solveOptions(logger)
+			// share the goroutines of a solve between the problems solved at once
+			options.Workers = max(1, c.Workers/workers)
+			return problem.Solve(solveCtx, options)
+		})
+		if err := ctx.Request.Context().Err(); err != nil {
+			logger.Warn("batch abandoned", "error", err)
+			return
+		}
+		if ctx.Query("format") == "csv" || ctx.NegotiateFormat(gin.MIMEJSON, mimeCSV) == mimeCSV {
+			ctx.Status(200)
+			ctx.Header("Content-Type", mimeCSV+"; charset=utf-8")
+			if err := report.WriteCSV(ctx.Writer); err != nil {
+				logger.Warn("report not sent", "error", err)
+			}
+			return
+		}
+		ctx.JSON(200, report)
+	}
+}
+
+// batchProblem reads a problem of a batch, format is the format it is written
+// in, text or json
+func batchProblem(p api.BatchProblem) (problem *api.Problem, format string, err error) {
+	if p.Model != nil {
+		return p.Model, "json", p.Model.Validate()
+	}
+	parsed, err := lp.ParseProblem(p.ProblemString)
+	if err != nil {
+		return nil, "text", err
+	}
+	return api.NewProblem(parsed), "text", nil
+}
@@ -0,0 +1,113 @@
+// Package batch solves many problems concurrently and reports their results
+// as JSON or CSV
+package batch
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"pnle/api"
+	"pnle/lp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// SolveFunc solves a problem of a batch, it must stop soon after ctx is done
+type SolveFunc func(ctx context.Context, problem *api.Problem) (*api.Result, error)
+
+// Instance is a problem of a batch
+type Instance struct {
+	Name    string
+	Problem *api.Problem
+	// Err tells why the problem could not be read, the instance is reported
+	// with it without being solved
+	Err error
+}
+
+// Result is the outcome of the solve of an instance
+type Result struct {
+	Name      string         `json:"name"`
+	Status    lp.SolveStatus `json:"status,omitempty"`
+	Objective *float64       `json:"objective,omitempty"`
+	// TimeSeconds is the time spent solving the instance
+	TimeSeconds float64 `json:"timeSeconds"`
+	Nodes       int     `json:"nodes"`
+	Error       string  `json:"error,omitempty"`
+}
+
+// Report is the combined report of a batch, its results are in the order of
+// the instances
+type Report struct {
+	Results []Result `json:"results"`
+	Workers int      `json:"workers"`
+	// TimeSeconds is the wall-clock time of the whole batch
+	TimeSeconds float64 `json:"timeSeconds"`
+}
+
+// Run solves the instances on workers goroutines
+func Run(ctx context.Context, instances []Instance, workers int, solve SolveFunc) *Report {
+	workers = max(1, min(workers, len(instances)))
+	report := &Report{Results: make([]Result, len(instances)), Workers: workers}
+	start := time.Now()
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				report.Results[i] = run(ctx, instances[i], solve)
+			}
+		}()
+	}
+	for i := range instances {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	report.TimeSeconds = time.Since(start).Seconds()
+	return report
+}
+
+func run(ctx context.Context, instance Instance, solve SolveFunc) Result {
+	result := Result{Name: instance.Name}
+	if instance.Err != nil {
+		result.Error = instance.Err.Error()
+		return result
+	}
+	start := time.Now()
+	solved, err := solve(ctx, instance.Problem)
+	result.TimeSeconds = time.Since(start).Seconds()
+	if err != nil {
+		result.Error = err.Error()
+	}
+	if solved != nil {
+		result.Status = solved.Status
+		result.Objective = solved.Objective
+		result.Nodes = solved.Statistics.Nodes
+	}
+	return result
+}
+
+// WriteCSV writes a line per instance after a header line
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"name", "status", "objective", "time_seconds", "nodes", "error"})
+	for _, result := range r.Results {
+		objective := ""
+		if result.Objective != nil {
+			objective = strconv.FormatFloat(*result.Objective, 'g', -1, 64)
+		}
+		writer.Write([]string{
+			result.Name,
+			string(result.Status),
+			objective,
+			strconv.FormatFloat(result.TimeSeconds, 'f', 6, 64),
+			strconv.Itoa(result.Nodes),
+			result.Error,
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"pnle/batch"
+	"pnle/internal/transport"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+const batchBody = `{"problems": [
+	{"name": "base", "problemString": "max 5 4\n6 4 <= 24\n1 2 <= 6"},
+	{"problemString": "max 1 1\n1 1 <= 3"},
+	{"name": "infeasible", "problemString": "max 1 1\n1 1 <= 1\n1 1 >= 2"}
+]}`
+
+// batchRouter routes POST /api/v1/batch to solveBatch
+func batchRouter(c *config, solves *transport.Semaphore) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/v1/batch", solveBatch(c, solves))
+	return r
+}
+
+func postBatch(r http.Handler, target string, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestSolveBatch(t *testing.T) {
+	c := defaultConfig()
+	c.BatchWorkers = 2
+	solves := transport.NewSemaphore(4)
+	r := batchRouter(c, solves)
+
+	response := postBatch(r, "/api/v1/batch", batchBody)
+	if response.Code != 200 {
+		t.Fatalf("POST /api/v1/batch answered %d: %s", response.Code, response.Body)
+	}
+	var report batch.Report
+	if err := json.Unmarshal(response.Body.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Workers != 2 {
+		t.Errorf("workers = %d, want 2", report.Workers)
+	}
+	want := []struct {
+		name      string
+		objective float64
+	}{{"base", 20}, {"2", 3}, {"infeasible", 0}}
+	if len(report.Results) != len(want) {
+		t.Fatalf("report has %d results, want %d", len(report.Results), len(want))
+	}
+	for i, w := range want {
+		result := report.Results[i]
+		if result.Name != w.name {
+			t.Errorf("result %d name = %q, want %q", i, result.Name, w.name)
+		}
+		if w.name == "infeasible" {
+			if result.Objective != nil {
+				t.Errorf("infeasible result has objective %v", *result.Objective)
+			}
+			continue
+		}
+		if result.Objective == nil || *result.Objective != w.objective {
+			t.Errorf("result %q objective = %v, want %v", w.name, result.Objective, w.objective)
+		}
+	}
+	if taken := solves.TryAcquire(4); taken != 4 {
+		t.Errorf("%d solves free after the batch, want 4", taken)
+	}
+	solves.Release(4)
+
+	csv := postBatch(r, "/api/v1/batch?format=csv", batchBody)
+	if csv.Code != 200 || !strings.HasPrefix(csv.Header().Get("Content-Type"), mimeCSV) {
+		t.Fatalf("POST /api/v1/batch?format=csv answered %d with %q", csv.Code, csv.Header().Get("Content-Type"))
+	}
+	if lines := strings.Split(strings.TrimSpace(csv.Body.String()), "\n"); len(lines) != 4 {
+		t.Errorf("CSV report has %d lines, want a header and 3 results:\n%s", len(lines), csv.Body)
+	}
+}
+
+func TestSolveBatchInvalid(t *testing.T) {
+	c := defaultConfig()
+	c.MaxBatch = 2
+	r := batchRouter(c, nil)
+	tests := []struct {
+		body string
+		code int
+	}{
+		{`{"problems": []}`, 400},
+		{`{"problems": [{"problemString": "max 1 x"}]}`, 400},
+		{batchBody, 413},
+	}
+	for _, test := range tests {
+		if response := postBatch(r, "/api/v1/batch", test.body); response.Code != test.code {
+			t.Errorf("POST %s answered %d, want %d", test.body, response.Code, test.code)
+		}
+	}
+}
+
+func TestSolveBatchSolves(t *testing.T) {
+	c := defaultConfig()
+	c.BatchWorkers = 3
+	solves := transport.NewSemaphore(3)
+	r := batchRouter(c, solves)
+
+	// other solves hold all the slots
+	solves.TryAcquire(3)
+	response := postBatch(r, "/api/v1/batch", batchBody)
+	if response.Code != 429 {
+		t.Errorf("batch without a free solve answered %d, want 429", response.Code)
+	}
+
+	// the batch solves as many problems at once as there are free solves
+	solves.Release(1)
+	response = postBatch(r, "/api/v1/batch", batchBody)
+	if response.Code != 200 {
+		t.Fatalf("batch with a free solve answered %d: %s", response.Code, response.Body)
+	}
+	var report batch.Report
+	if err := json.Unmarshal(response.Body.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Workers != 1 {
+		t.Errorf("workers = %d, want the single free solve", report.Workers)
+	}
+	if taken := solves.TryAcquire(1); taken != 1 {
+		t.Error("the batch did not release its solve")
+	}
+}
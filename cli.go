@@ -0,0 +1,355 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"pnle/api"
+	"pnle/batch"
+	"pnle/lp"
+	"pnle/modelfile"
+	"runtime"
+	"strings"
+)
+
+const (
+	exitOK = iota
+	exitError
+	exitInfeasible
+	exitUnbounded
+	exitNoSolution
+)
+
+// solveReport is the result printed by the solve command
+type solveReport struct {
+	Status      lp.SolveStatus       `json:"status"`
+	Objective   *float64             `json:"objective,omitempty"`
+	Values      []float64            `json:"values,omitempty"`
+	DualBound   float64              `json:"dualBound"`
+	AbsoluteGap float64              `json:"absoluteGap"`
+	RelativeGap float64              `json:"relativeGap"`
+	Nodes       int                  `json:"nodes"`
+	Tableaux    []*lp.SimplexTableau `json:"tableaux,omitempty"`
+	// problem is kept for the markdown expression of the problem
+	problem *lp.LinearProblem
+}
+
+// readProblem reads a model file, "-" is the standard input. The file is
+// written in from, or when it is empty in the format detected from its name and
+// content
+func readProblem(filename string, from string) (*api.Problem, error) {
+	var content []byte
+	var err error
+	if filename == "-" {
+		content, err = io.ReadAll(os.Stdin)
+		filename = ""
+	} else {
+		content, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, err
+	}
+	format := modelfile.Detect(filename, content)
+	if from != "" {
+		if format, err = modelfile.ParseFormat(from); err != nil {
+			return nil, err
+		}
+	}
+	problem, err := modelfile.Parse(format, content)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateProblem(problem); err != nil {
+		return nil, err
+	}
+	return problem, nil
+}
+
+// parseArgs parses the flags of a command that takes a single problem file
+func parseArgs(flags *flag.FlagSet, args []string) (string, bool) {
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: pnle %s [flags] <file>\n", flags.Name())
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return "", false
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return "", false
+	}
+	return flags.Arg(0), true
+}
+
+func solve(args []string) int {
+	flags := flag.NewFlagSet("solve", flag.ContinueOnError)
+	format := flags.String("format", "text", "output format: text, json, markdown, or the documents latex, csv and html")
+	from := flags.String("from", "", "format of the problem file: text, mps, lp or json, detected when empty")
+	tableaux := flags.Bool("tableaux", false, "print the simplex tableaux of the solution")
+	verbose := flags.Bool("verbose", false, "log the progress of the solver to the standard error")
+	workers := flags.Int("workers", 0, "number of goroutines solving node relaxations, 0 for one per CPU")
+	timeLimit := flags.Duration("time-limit", 0, "stop the search after this duration, 0 for no limit")
+	nodeLimit := flags.Int("node-limit", 0, "stop the search after this number of nodes, 0 for no limit")
+	gap := flags.Float64("gap", lp.DefaultSolveOptions().RelativeGapTolerance, "relative gap at which the search stops")
+	filename, ok := parseArgs(flags, args)
+	if !ok {
+		return exitError
+	}
+	if _, ok := exportFormats[*format]; !ok && *format != "text" && *format != "json" && *format != "markdown" {
+		fmt.Fprintf(os.Stderr, "unknown output format %q\n", *format)
+		return exitError
+	}
+	model, err := readProblem(filename, *from)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitError
+	}
+
+	problem := model.IntegerProblem()
+	options := lp.DefaultSolveOptions()
+	// the default options have one worker per CPU
+	if *workers > 0 {
+		options.Workers = *workers
+	}
+	options.TimeLimit = *timeLimit
+	options.NodeLimit = *nodeLimit
+	options.RelativeGapTolerance = *gap
+	if *verbose {
+		// the progress goes to the standard error, apart from the solution
+		options.Logger, _ = newLogger(os.Stderr, "trace", "text")
+	}
+	solution, err := problem.SolveContext(context.Background(), options)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitError
+	}
+
+	report := solveReport{
+		Status:      problem.Status,
+		DualBound:   problem.DualBound,
+		AbsoluteGap: problem.AbsoluteGap,
+		RelativeGap: problem.RelativeGap,
+		Nodes:       problem.Nodes,
+		problem:     &problem.InitialProblem,
+	}
+	if solution != nil {
+		report.Objective = &problem.OptimalObjectiveFunctionValue
+		report.Values = problem.OptimalVariableValues
+		if *tableaux {
+			report.Tableaux = solution.SolutionSteps
+		}
+	}
+	switch *format {
+	case "json":
+		output, _ := json.MarshalIndent(report, "", "  ")
+		fmt.Println(string(output))
+	case "markdown":
+		fmt.Print(report.markdown())
+	case "latex":
+		fmt.Print(problem.LaTeX())
+	case "csv":
+		if err := problem.WriteCSV(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return exitError
+		}
+	case "html":
+		document, err := problem.HTML()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return exitError
+		}
+		fmt.Print(document)
+	default:
+		fmt.Print(report.text())
+	}
+
+	switch {
+	case problem.Status == lp.StatusUnbounded:
+		return exitUnbounded
+	case problem.Status == lp.StatusInfeasible:
+		return exitInfeasible
+	case solution == nil:
+		return exitNoSolution
+	}
+	return exitOK
+}
+
+func (report *solveReport) text() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Status: %s\n", report.Status))
+	if report.Objective == nil {
+		return sb.String()
+	}
+	sb.WriteString(fmt.Sprintf("Z = %v\n", *report.Objective))
+	for i, value := range report.Values {
+		sb.WriteString(fmt.Sprintf("x%v = %v\n", i+1, value))
+	}
+	sb.WriteString(fmt.Sprintf("Nodes: %v, dual bound: %v, gap: %v (%.2f%%)\n",
+		report.Nodes, report.DualBound, report.AbsoluteGap, report.RelativeGap*100))
+	for _, tableau := range report.Tableaux {
+		sb.WriteString(fmt.Sprintf("\nPhase %v, iteration %v\n", tableau.Phase, tableau.Iteration))
+		sb.WriteString(tableau.String())
+		if tableau.Explanation != "" {
+			sb.WriteString(tableau.Explanation + "\n")
+		}
+	}
+	return sb.String()
+}
+
+func (report *solveReport) markdown() string {
+	var sb strings.Builder
+	sb.WriteString("## Problem\n\n")
+	sb.WriteString(problemMarkdown(report.problem))
+	sb.WriteString("\n\n## Solution\n\n")
+	sb.WriteString(fmt.Sprintf("**Status:** %s\n\n", report.Status))
+	if report.Objective == nil {
+		return sb.String()
+	}
+	sb.WriteString("| Variable | Value |\n| --- | --- |\n")
+	for i, value := range report.Values {
+		sb.WriteString(fmt.Sprintf("| x%v | %v |\n", i+1, value))
+	}
+	sb.WriteString(fmt.Sprintf("| Z | %v |\n\n", *report.Objective))
+	sb.WriteString(fmt.Sprintf("Nodes: %v, dual bound: %v, gap: %v (%.2f%%)\n",
+		report.Nodes, report.DualBound, report.AbsoluteGap, report.RelativeGap*100))
+	for _, tableau := range report.Tableaux {
+		sb.WriteString(fmt.Sprintf("\n### Phase %v, iteration %v\n\n", tableau.Phase, tableau.Iteration))
+		sb.WriteString("| " + strings.Join(tableau.Headers, " | ") + " |\n")
+		sb.WriteString(strings.Repeat("| --- ", len(tableau.Headers)) + "|\n")
+		for i, row := range tableau.Tableau {
+			sb.WriteString(fmt.Sprintf("| **%s** | %s |\n", tableau.BaseVariables[i], strings.Join(row, " | ")))
+		}
+		if tableau.Explanation != "" {
+			sb.WriteString("\n" + tableau.Explanation + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// problemMarkdown is the math block of CreateMarkdownExpression for a parsed problem
+func problemMarkdown(problem *lp.LinearProblem) string {
+	wrapper := &lp.LinearProblem{IsMaximization: problem.IsMaximization, OriginalProblem: problem}
+	return wrapper.CreateMarkdownExpression()
+}
+
+func convert(args []string) int {
+	flags := flag.NewFlagSet("convert", flag.ContinueOnError)
+	to := flags.String("to", "json", "output format: text, json or markdown")
+	from := flags.String("from", "", "format of the problem file: text, mps, lp or json, detected when empty")
+	filename, ok := parseArgs(flags, args)
+	if !ok {
+		return exitError
+	}
+	model, err := readProblem(filename, *from)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitError
+	}
+	// the text format and the markdown only know the decision variables x1, x2...
+	problem := &model.IntegerProblem().InitialProblem
+	switch *to {
+	case "text":
+		fmt.Println(problem.Text())
+	case "markdown":
+		fmt.Println(problemMarkdown(problem))
+	case "json":
+		// the typed model of the JSON API, which modelfile reads back
+		output, _ := json.MarshalIndent(model, "", "  ")
+		fmt.Println(string(output))
+	default:
+		fmt.Fprintf(os.Stderr, "unknown output format %q\n", *to)
+		return exitError
+	}
+	return exitOK
+}
+
+// runBatch solves the problem files matched by the arguments, which are file
+// names or glob patterns, and prints the report
+func runBatch(args []string) int {
+	flags := flag.NewFlagSet("batch", flag.ContinueOnError)
+	format := flags.String("format", "csv", "output format: csv or json")
+	from := flags.String("from", "", "format of the problem files: text, mps, lp or json, detected when empty")
+	workers := flags.Int("workers", runtime.NumCPU(), "number of problems solved at the same time")
+	timeLimit := flags.Duration("time-limit", 0, "stop the search of each problem after this duration, 0 for no limit")
+	nodeLimit := flags.Int("node-limit", 0, "stop the search of each problem after this number of nodes, 0 for no limit")
+	gap := flags.Float64("gap", lp.DefaultSolveOptions().RelativeGapTolerance, "relative gap at which the searches stop")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: pnle batch [flags] <file or glob>...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+	if flags.NArg() == 0 || *workers < 1 {
+		flags.Usage()
+		return exitError
+	}
+	if *format != "csv" && *format != "json" {
+		fmt.Fprintf(os.Stderr, "unknown output format %q\n", *format)
+		return exitError
+	}
+	filenames, err := expandGlobs(flags.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitError
+	}
+
+	instances := make([]batch.Instance, len(filenames))
+	for i, filename := range filenames {
+		instances[i] = batch.Instance{Name: filename}
+		instances[i].Problem, instances[i].Err = readProblem(filename, *from)
+	}
+	options := lp.DefaultSolveOptions()
+	// share the CPUs between the problems solved at once
+	options.Workers = max(1, runtime.NumCPU()/(*workers))
+	options.TimeLimit = *timeLimit
+	options.NodeLimit = *nodeLimit
+	options.RelativeGapTolerance = *gap
+	report := batch.Run(context.Background(), instances, *workers, func(ctx context.Context, problem *api.Problem) (*api.Result, error) {
+		return problem.Solve(ctx, options)
+	})
+
+	switch *format {
+	case "json":
+		output, _ := json.MarshalIndent(report, "", "  ")
+		fmt.Println(string(output))
+	default:
+		if err := report.WriteCSV(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return exitError
+		}
+	}
+	for _, result := range report.Results {
+		if result.Error != "" {
+			return exitError
+		}
+	}
+	return exitOK
+}
+
+// expandGlobs replaces the glob patterns of args by the files they match, in
+// order, a pattern matching no file is an error
+func expandGlobs(args []string) ([]string, error) {
+	var filenames []string
+	for _, arg := range args {
+		matches, err := filepath.Glob(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", arg, err)
+		}
+		if len(matches) == 0 {
+			// a plain file name is kept, so that the missing file is reported
+			if !strings.ContainsAny(arg, "*?[") {
+				filenames = append(filenames, arg)
+				continue
+			}
+			return nil, errors.New("no file matches " + arg)
+		}
+		filenames = append(filenames, matches...)
+	}
+	return filenames, nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestExpandGlobs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.txt", "b.txt", "c.lp"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("max 1 1\n1 1 <= 3"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	missing := filepath.Join(dir, "missing.txt")
+
+	filenames, err := expandGlobs([]string{filepath.Join(dir, "*.txt"), missing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt"), missing}
+	if !reflect.DeepEqual(filenames, want) {
+		t.Errorf("expandGlobs() = %v, want %v", filenames, want)
+	}
+	if _, err := expandGlobs([]string{filepath.Join(dir, "*.mps")}); err == nil {
+		t.Error("expandGlobs() of a glob without a match succeeded")
+	}
+	if _, err := expandGlobs([]string{filepath.Join(dir, "[")}); err == nil {
+		t.Error("expandGlobs() of an invalid pattern succeeded")
+	}
+}
+
+func TestRunBatch(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.txt", "b.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("max 5 4\n6 4 <= 24\n1 2 <= 6"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the report is written to the standard output
+	stdout := os.Stdout
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = devNull
+	defer func() {
+		os.Stdout = stdout
+		devNull.Close()
+	}()
+
+	if code := runBatch([]string{"-workers", "2", filepath.Join(dir, "*.txt")}); code != exitOK {
+		t.Errorf("runBatch() of the glob = %d, want %d", code, exitOK)
+	}
+	if code := runBatch([]string{filepath.Join(dir, "*.txt"), filepath.Join(dir, "missing.txt")}); code != exitError {
+		t.Errorf("runBatch() with a missing file = %d, want %d", code, exitError)
+	}
+	if code := runBatch([]string{filepath.Join(dir, "*.mps")}); code != exitError {
+		t.Errorf("runBatch() of a glob without a match = %d, want %d", code, exitError)
+	}
+}
@@ -0,0 +1,269 @@
+// Package client calls the HTTP API of the solver server. Its types are generated
+// from the OpenAPI document served at /openapi.json, so the package depends on
+// none of the packages of the server
+package client
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config oapi-codegen.yaml ../api/openapi.json
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Client calls a server, its fields must not be changed while it is in use
+type Client struct {
+	// BaseURL is the URL of the server, like http://localhost:8080
+	BaseURL string
+	// HTTPClient sends the requests, http.DefaultClient when it is nil
+	HTTPClient *http.Client
+}
+
+func New(baseURL string) *Client {
+	return &Client{BaseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// Error is an error response of the server
+type Error struct {
+	StatusCode int
+	Message    string
+	// RetryAfter is how long to wait before retrying a request refused with 429,
+	// zero when the server did not tell
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Event is a server-sent event of SolveStream, Data holds the JSON of the event,
+// see the description of POST /solve/stream for the events and their fields
+type Event struct {
+	Name string
+	Data json.RawMessage
+}
+
+// Solve solves a problem in the text format
+func (c *Client) Solve(ctx context.Context, problemString string) (*SolveResponse, error) {
+	var response SolveResponse
+	err := c.do(ctx, http.MethodPost, "/solve", TextProblem{ProblemString: problemString}, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// SolveStream solves a problem in the text format, calling onEvent with each
+// progress event until the result, which it returns. It stops with the error
+// of onEvent when there is one
+func (c *Client) SolveStream(ctx context.Context, problemString string, onEvent func(Event) error) (*SolveResponse, error) {
+	response, err := c.send(ctx, http.MethodPost, "/solve/stream", TextProblem{ProblemString: problemString})
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, responseError(response)
+	}
+	scanner := bufio.NewScanner(response.Body)
+	// the result holds every tableau of the solve
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	var event Event
+	var data bytes.Buffer
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event.Name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(line, "data:"))
+		case line == "" && event.Name != "":
+			event.Data = append(json.RawMessage(nil), data.Bytes()...)
+			if event.Name == "result" {
+				var result SolveResponse
+				if err := json.Unmarshal(event.Data, &result); err != nil {
+					return nil, err
+				}
+				return &result, nil
+			}
+			if err := onEvent(event); err != nil {
+				return nil, err
+			}
+			event = Event{}
+			data.Reset()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.ErrUnexpectedEOF
+}
+
+// Export solves a problem in the text format and returns its document in
+// format, latex, csv or html
+func (c *Client) Export(ctx context.Context, problemString string, format string) ([]byte, error) {
+	response, err := c.send(ctx, http.MethodPost, "/solve/export?format="+url.QueryEscape(format), TextProblem{ProblemString: problemString})
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, responseError(response)
+	}
+	return io.ReadAll(response.Body)
+}
+
+// SolveProblem solves a problem of the typed model
+func (c *Client) SolveProblem(ctx context.Context, problem *Problem) (*Result, error) {
+	var result Result
+	if err := c.do(ctx, http.MethodPost, "/api/v1/solve", problem, &result, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SolveBatch solves many problems concurrently and returns the report of the batch
+func (c *Client) SolveBatch(ctx context.Context, request *BatchRequest) (*BatchReport, error) {
+	var report BatchReport
+	if err := c.do(ctx, http.MethodPost, "/api/v1/batch", request, &report, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// SubmitJob queues a solve of problem, the server answers 503 when its queue is full
+func (c *Client) SubmitJob(ctx context.Context, problem *Problem) (*Job, error) {
+	var job Job
+	if err := c.do(ctx, http.MethodPost, "/api/jobs", problem, &job, http.StatusAccepted); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// Job returns a job, with its result once it is done
+func (c *Client) Job(ctx context.Context, id string) (*Job, error) {
+	var job Job
+	if err := c.do(ctx, http.MethodGet, "/api/jobs/"+url.PathEscape(id), nil, &job, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// CancelJob cancels a queued or running job. When the job is already finished
+// it returns the job along with an Error of status 409
+func (c *Client) CancelJob(ctx context.Context, id string) (*Job, error) {
+	response, err := c.send(ctx, http.MethodDelete, "/api/jobs/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	switch response.StatusCode {
+	case http.StatusOK:
+		var job Job
+		if err := json.NewDecoder(response.Body).Decode(&job); err != nil {
+			return nil, err
+		}
+		return &job, nil
+	case http.StatusConflict:
+		var body struct {
+			Error string `json:"error"`
+			Job   Job    `json:"job"`
+		}
+		if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		return &body.Job, &Error{StatusCode: response.StatusCode, Message: body.Error}
+	}
+	return nil, responseError(response)
+}
+
+// History lists the solved problems, latest first
+func (c *Client) History(ctx context.Context) ([]HistorySummary, error) {
+	var summaries []HistorySummary
+	if err := c.do(ctx, http.MethodGet, "/api/history", nil, &summaries, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// HistoryEntry returns a solved problem with the response of its solve
+func (c *Client) HistoryEntry(ctx context.Context, id string) (*HistoryEntry, error) {
+	var entry HistoryEntry
+	if err := c.do(ctx, http.MethodGet, "/api/history/"+url.PathEscape(id), nil, &entry, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (c *Client) DeleteHistory(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/api/history/"+url.PathEscape(id), nil, nil, http.StatusNoContent)
+}
+
+// Ready returns nil when the server takes new solves
+func (c *Client) Ready(ctx context.Context) error {
+	return c.do(ctx, http.MethodGet, "/readyz", nil, nil, http.StatusOK)
+}
+
+// do sends a request with body as JSON and decodes the response into out,
+// unless it is nil, when the server answers with status
+func (c *Client) do(ctx context.Context, method string, path string, body any, out any, status int) error {
+	response, err := c.send(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != status {
+		return responseError(response)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(response.Body).Decode(out)
+}
+
+func (c *Client) send(ctx context.Context, method string, path string, body any) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(content)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return httpClient.Do(request)
+}
+
+// responseError reads the error of an unexpected response
+func responseError(response *http.Response) error {
+	e := &Error{StatusCode: response.StatusCode, Message: response.Status}
+	var body struct {
+		Error string `json:"error"`
+	}
+	content, _ := io.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if json.Unmarshal(content, &body) == nil && body.Error != "" {
+		e.Message = body.Error
+	}
+	if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil {
+		e.RetryAfter = time.Duration(seconds) * time.Second
+	}
+	return e
+}
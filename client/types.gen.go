@@ -0,0 +1,442 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package client
+
+import (
+	"time"
+)
+
+// Defines values for ConstraintType.
+const (
+	ConstraintTypeEqual            ConstraintType = "="
+	ConstraintTypeGreaterThanEqual ConstraintType = ">="
+	ConstraintTypeLessThanEqual    ConstraintType = "<="
+)
+
+// Defines values for JobStatus.
+const (
+	JobStatusCanceled JobStatus = "canceled"
+	JobStatusDone     JobStatus = "done"
+	JobStatusFailed   JobStatus = "failed"
+	JobStatusQueued   JobStatus = "queued"
+	JobStatusRunning  JobStatus = "running"
+)
+
+// Defines values for ModelFileFormat.
+const (
+	ModelFileFormatJson ModelFileFormat = "json"
+	ModelFileFormatLp   ModelFileFormat = "lp"
+	ModelFileFormatMps  ModelFileFormat = "mps"
+	ModelFileFormatText ModelFileFormat = "text"
+)
+
+// Defines values for ObjectiveSense.
+const (
+	Max ObjectiveSense = "max"
+	Min ObjectiveSense = "min"
+)
+
+// Defines values for SolveStatus.
+const (
+	SolveStatusCanceled      SolveStatus = "canceled"
+	SolveStatusGapLimit      SolveStatus = "gap_limit"
+	SolveStatusInfeasible    SolveStatus = "infeasible"
+	SolveStatusNodeLimit     SolveStatus = "node_limit"
+	SolveStatusOptimal       SolveStatus = "optimal"
+	SolveStatusSolutionLimit SolveStatus = "solution_limit"
+	SolveStatusTimeLimit     SolveStatus = "time_limit"
+	SolveStatusUnbounded     SolveStatus = "unbounded"
+)
+
+// Defines values for TreeNodeBranchDirection.
+const (
+	TreeNodeBranchDirectionGreaterThanEqual TreeNodeBranchDirection = ">="
+	TreeNodeBranchDirectionLessThanEqual    TreeNodeBranchDirection = "<="
+)
+
+// Defines values for TreeNodeStatus.
+const (
+	Branched      TreeNodeStatus = "branched"
+	Infeasible    TreeNodeStatus = "infeasible"
+	Integral      TreeNodeStatus = "integral"
+	Open          TreeNodeStatus = "open"
+	PrunedByBound TreeNodeStatus = "pruned_by_bound"
+	Unbounded     TreeNodeStatus = "unbounded"
+)
+
+// Defines values for VariableType.
+const (
+	Binary     VariableType = "binary"
+	Continuous VariableType = "continuous"
+	Integer    VariableType = "integer"
+)
+
+// Defines values for SolveBatchParamsFormat.
+const (
+	SolveBatchParamsFormatCsv  SolveBatchParamsFormat = "csv"
+	SolveBatchParamsFormatJson SolveBatchParamsFormat = "json"
+)
+
+// Defines values for ExportParamsFormat.
+const (
+	Csv   ExportParamsFormat = "csv"
+	Html  ExportParamsFormat = "html"
+	Latex ExportParamsFormat = "latex"
+)
+
+// BatchProblem A problem of a batch, in the text format or of the typed model, exactly one of problemString and model is set
+type BatchProblem struct {
+	Model *Problem `json:"model,omitempty"`
+
+	// Name Defaults to the position of the problem, from 1
+	Name          *string `json:"name,omitempty"`
+	ProblemString *string `json:"problemString,omitempty"`
+}
+
+// BatchReport defines model for BatchReport.
+type BatchReport struct {
+	Results []BatchResult `json:"results"`
+
+	// TimeSeconds Wall-clock time of the whole batch
+	TimeSeconds float64 `json:"timeSeconds"`
+	Workers     int     `json:"workers"`
+}
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	Problems []BatchProblem `json:"problems"`
+
+	// Workers Number of problems solved at the same time, capped by the server
+	Workers *int `json:"workers,omitempty"`
+}
+
+// BatchResult defines model for BatchResult.
+type BatchResult struct {
+	// Error Why the problem was not solved to the end
+	Error       *string      `json:"error,omitempty"`
+	Name        string       `json:"name"`
+	Nodes       int          `json:"nodes"`
+	Objective   *float64     `json:"objective,omitempty"`
+	Status      *SolveStatus `json:"status,omitempty"`
+	TimeSeconds float64      `json:"timeSeconds"`
+}
+
+// BranchAndBoundTree defines model for BranchAndBoundTree.
+type BranchAndBoundTree struct {
+	Nodes []TreeNode `json:"nodes"`
+}
+
+// Constraint defines model for Constraint.
+type Constraint struct {
+	Coefficients map[string]float64 `json:"coefficients"`
+
+	// Name Defaults to c1, c2... after the position of the constraint
+	Name *string        `json:"name,omitempty"`
+	Rhs  *float64       `json:"rhs,omitempty"`
+	Type ConstraintType `json:"type"`
+}
+
+// ConstraintType defines model for Constraint.Type.
+type ConstraintType string
+
+// Graphical Solution of a two variable problem by the graphical method
+type Graphical struct {
+	Solution GraphicalSolution `json:"solution"`
+	Svg      string            `json:"svg"`
+}
+
+// GraphicalSolution defines model for GraphicalSolution.
+type GraphicalSolution struct {
+	Feasible       bool    `json:"feasible"`
+	IntegerOptimum *Point  `json:"integerOptimum,omitempty"`
+	IntegerPoints  []Point `json:"integerPoints,omitempty"`
+	Optimum        *Vertex `json:"optimum,omitempty"`
+	Size           float64 `json:"size"`
+	Unbounded      bool    `json:"unbounded"`
+
+	// Vertices Corners of the feasible region in counter-clockwise order
+	Vertices []Vertex `json:"vertices"`
+}
+
+// HistoryEntry defines model for HistoryEntry.
+type HistoryEntry struct {
+	CreatedAt time.Time     `json:"createdAt"`
+	Id        string        `json:"id"`
+	Objective *float64      `json:"objective,omitempty"`
+	Problem   string        `json:"problem"`
+	Response  SolveResponse `json:"response"`
+	Status    string        `json:"status"`
+}
+
+// HistorySummary defines model for HistorySummary.
+type HistorySummary struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        string    `json:"id"`
+	Objective *float64  `json:"objective,omitempty"`
+
+	// Problem The problem in the text format
+	Problem string `json:"problem"`
+	Status  string `json:"status"`
+}
+
+// Job defines model for Job.
+type Job struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	Id         string     `json:"id"`
+	Result     *Result    `json:"result,omitempty"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	Status     JobStatus  `json:"status"`
+}
+
+// JobStatus defines model for Job.Status.
+type JobStatus string
+
+// ModelFile defines model for ModelFile.
+type ModelFile struct {
+	// File The problem in the text format of problemString, the free MPS format, the CPLEX LP format or the JSON of Problem. The variables of MPS and LP files are continuous unless declared integer or binary, and they must be non negative.
+	File []byte `json:"file"`
+
+	// Format The format of the file, detected from its extension (.mps, .lp or .json) or else from its content when it is not given.
+	Format *ModelFileFormat `json:"format,omitempty"`
+}
+
+// ModelFileFormat The format of the file, detected from its extension (.mps, .lp or .json) or else from its content when it is not given.
+type ModelFileFormat string
+
+// Objective defines model for Objective.
+type Objective struct {
+	// Coefficients Keyed by variable name
+	Coefficients map[string]float64 `json:"coefficients"`
+	Sense        ObjectiveSense     `json:"sense"`
+}
+
+// ObjectiveSense defines model for Objective.Sense.
+type ObjectiveSense string
+
+// Options defines model for Options.
+type Options struct {
+	Deterministic     *bool    `json:"deterministic,omitempty"`
+	DisableHeuristics *bool    `json:"disableHeuristics,omitempty"`
+	NodeLimit         *int     `json:"nodeLimit,omitempty"`
+	RelativeGap       *float64 `json:"relativeGap,omitempty"`
+	SolutionLimit     *int     `json:"solutionLimit,omitempty"`
+	TimeLimitSeconds  *float64 `json:"timeLimitSeconds,omitempty"`
+
+	// Workers Goroutines of the branch and bound, capped by the -workers of the server
+	Workers *int `json:"workers,omitempty"`
+}
+
+// Point defines model for Point.
+type Point struct {
+	X1 float64 `json:"x1"`
+	X2 float64 `json:"x2"`
+}
+
+// Problem defines model for Problem.
+type Problem struct {
+	Constraints []Constraint `json:"constraints,omitempty"`
+	Name        *string      `json:"name,omitempty"`
+	Objective   Objective    `json:"objective"`
+	Options     *Options     `json:"options,omitempty"`
+	Variables   []Variable   `json:"variables"`
+}
+
+// Result defines model for Result.
+type Result struct {
+	// Duals Shadow prices of the constraints in the LP relaxation, keyed by constraint name
+	Duals      map[string]float64 `json:"duals,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Objective  *float64           `json:"objective,omitempty"`
+	Statistics Statistics         `json:"statistics"`
+	Status     SolveStatus        `json:"status"`
+	Values     map[string]float64 `json:"values,omitempty"`
+}
+
+// SimplexTableau defines model for SimplexTableau.
+type SimplexTableau struct {
+	BaseVariables    []string `json:"baseVariables"`
+	EnteringVariable *string  `json:"enteringVariable,omitempty"`
+	Explanation      *string  `json:"explanation,omitempty"`
+	Headers          []string `json:"headers"`
+	Iteration        int      `json:"iteration"`
+	LeavingVariable  *string  `json:"leavingVariable,omitempty"`
+	Phase            int      `json:"phase"`
+
+	// PivotColumn -1 when no pivot follows the tableau
+	PivotColumn int `json:"pivotColumn"`
+
+	// PivotRow -1 when no pivot follows the tableau
+	PivotRow int `json:"pivotRow"`
+
+	// Ratios The ratio test of each row, empty for the rows that do not take part
+	Ratios []string `json:"ratios,omitempty"`
+
+	// Tableau The cells as fractions, one row per base variable and the objective row last
+	Tableau [][]string `json:"tableau"`
+}
+
+// SolveResponse defines model for SolveResponse.
+type SolveResponse struct {
+	AbsoluteGap float64 `json:"absoluteGap"`
+
+	// DualBound Best objective value the open nodes could still reach, also set without a solution, 0 when it is infinite. The gaps are 0 without a solution
+	DualBound float64 `json:"dualBound"`
+
+	// Error Why there is no solution, the fields of the solution are not set when there is one
+	Error         *string    `json:"error,omitempty"`
+	Graphical     *Graphical `json:"graphical"`
+	HistoryId     *string    `json:"historyId,omitempty"`
+	IncumbentNode *int       `json:"incumbentNode,omitempty"`
+
+	// IncumbentSource branch and bound, or the name of the heuristic that found the solution, whose tableaux are then those of the root problem with the integer variables fixed to their values
+	IncumbentSource *string `json:"incumbentSource,omitempty"`
+
+	// NodeTableaux Simplex tableaux of each node, keyed by node ID, of at most -max-node-tableaux nodes
+	NodeTableaux map[string][]SimplexTableau `json:"nodeTableaux"`
+
+	// NodeTableauxTruncated Set when nodeTableaux only has the nodes with the lowest IDs and the incumbent node, the search having more nodes than -max-node-tableaux
+	NodeTableauxTruncated *bool `json:"nodeTableauxTruncated,omitempty"`
+	Nodes                 int   `json:"nodes"`
+
+	// ProblemHtml The problem written in HTML
+	ProblemHtml *string `json:"problemHtml,omitempty"`
+	RelativeGap float64 `json:"relativeGap"`
+
+	// SolutionHtml The table of the values of the variables and of the objective, written in HTML
+	SolutionHtml *string `json:"solutionHtml,omitempty"`
+
+	// SolutionProblemString Markdown of the problem
+	SolutionProblemString *string `json:"solutionProblemString,omitempty"`
+
+	// SolutionString Markdown of the solution
+	SolutionString *string            `json:"solutionString,omitempty"`
+	Status         SolveStatus        `json:"status"`
+	Tableaux       []SimplexTableau   `json:"tableaux,omitempty"`
+	Tree           BranchAndBoundTree `json:"tree"`
+
+	// TreeDot The tree in the Graphviz DOT language
+	TreeDot string `json:"treeDot"`
+}
+
+// SolveStatus defines model for SolveStatus.
+type SolveStatus string
+
+// Statistics defines model for Statistics.
+type Statistics struct {
+	AbsoluteGap float64 `json:"absoluteGap"`
+
+	// DualBound Best objective value the open nodes could still reach, also set without a solution, 0 when it is infinite. The gaps are 0 without a solution
+	DualBound        float64 `json:"dualBound"`
+	Nodes            int     `json:"nodes"`
+	RelativeGap      float64 `json:"relativeGap"`
+	SolveTimeSeconds float64 `json:"solveTimeSeconds"`
+}
+
+// Status defines model for Status.
+type Status struct {
+	Status string `json:"status"`
+}
+
+// TextProblem defines model for TextProblem.
+type TextProblem struct {
+	// ProblemString The first line is max or min followed by the objective coefficients, each following line is a constraint written as its coefficients, its type (<=, >= or =) and its right hand side, all separated by spaces.
+	ProblemString string `json:"problemString"`
+}
+
+// TreeNode defines model for TreeNode.
+type TreeNode struct {
+	// Bound Relaxation value of the parent
+	Bound           *float64                 `json:"bound,omitempty"`
+	BranchBound     float64                  `json:"branchBound"`
+	BranchDirection *TreeNodeBranchDirection `json:"branchDirection,omitempty"`
+
+	// BranchVariable Index of the variable bounded to create the node, -1 for the root
+	BranchVariable int  `json:"branchVariable"`
+	Depth          int  `json:"depth"`
+	Id             int  `json:"id"`
+	Incumbent      bool `json:"incumbent"`
+
+	// ParentId 0 for the root
+	ParentId int            `json:"parentId"`
+	Status   TreeNodeStatus `json:"status"`
+
+	// Value Relaxation value of the node
+	Value *float64 `json:"value,omitempty"`
+}
+
+// TreeNodeBranchDirection defines model for TreeNode.BranchDirection.
+type TreeNodeBranchDirection string
+
+// TreeNodeStatus defines model for TreeNode.Status.
+type TreeNodeStatus string
+
+// Variable defines model for Variable.
+type Variable struct {
+	Lower *float64      `json:"lower,omitempty"`
+	Name  string        `json:"name"`
+	Type  *VariableType `json:"type,omitempty"`
+	Upper *float64      `json:"upper,omitempty"`
+}
+
+// VariableType defines model for Variable.Type.
+type VariableType string
+
+// Vertex defines model for Vertex.
+type Vertex struct {
+	Optimal bool    `json:"optimal"`
+	Value   float64 `json:"value"`
+	X1      float64 `json:"x1"`
+	X2      float64 `json:"x2"`
+}
+
+// SolveBatchParams defines parameters for SolveBatch.
+type SolveBatchParams struct {
+	Format *SolveBatchParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// SolveBatchParamsFormat defines parameters for SolveBatch.
+type SolveBatchParamsFormat string
+
+// ExportParams defines parameters for Export.
+type ExportParams struct {
+	Format *ExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportParamsFormat defines parameters for Export.
+type ExportParamsFormat string
+
+// SubmitJobJSONRequestBody defines body for SubmitJob for application/json ContentType.
+type SubmitJobJSONRequestBody = Problem
+
+// SubmitJobMultipartRequestBody defines body for SubmitJob for multipart/form-data ContentType.
+type SubmitJobMultipartRequestBody = ModelFile
+
+// SolveBatchJSONRequestBody defines body for SolveBatch for application/json ContentType.
+type SolveBatchJSONRequestBody = BatchRequest
+
+// SolveV1JSONRequestBody defines body for SolveV1 for application/json ContentType.
+type SolveV1JSONRequestBody = Problem
+
+// SolveV1MultipartRequestBody defines body for SolveV1 for multipart/form-data ContentType.
+type SolveV1MultipartRequestBody = ModelFile
+
+// SolveJSONRequestBody defines body for Solve for application/json ContentType.
+type SolveJSONRequestBody = TextProblem
+
+// SolveMultipartRequestBody defines body for Solve for multipart/form-data ContentType.
+type SolveMultipartRequestBody = ModelFile
+
+// ExportJSONRequestBody defines body for Export for application/json ContentType.
+type ExportJSONRequestBody = TextProblem
+
+// ExportMultipartRequestBody defines body for Export for multipart/form-data ContentType.
+type ExportMultipartRequestBody = ModelFile
+
+// SolveStreamJSONRequestBody defines body for SolveStream for application/json ContentType.
+type SolveStreamJSONRequestBody = TextProblem
+
+// SolveStreamMultipartRequestBody defines body for SolveStream for multipart/form-data ContentType.
+type SolveStreamMultipartRequestBody = ModelFile
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"pnle/jobs"
+	"pnle/lp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// envPrefix starts the name of the environment variables of the flags of serve,
+// -job-workers is set by PNLE_JOB_WORKERS
+const envPrefix = "PNLE_"
+
+// config is the configuration of the server. Each field is set, from the lowest
+// to the highest precedence, by its default, the config file, the environment
+// and the flags of serve
+type config struct {
+	Addr string `yaml:"addr" toml:"addr"`
+	// GRPCAddr is the address of the gRPC server, empty to run none
+	GRPCAddr string `yaml:"grpc-addr" toml:"grpc-addr"`
+	TLSCert  string `yaml:"tls-cert" toml:"tls-cert"`
+	TLSKey   string `yaml:"tls-key" toml:"tls-key"`
+	// Mode is the gin mode: debug, release or test
+	Mode string `yaml:"mode" toml:"mode"`
+	// BodyLimit is the largest request body in bytes
+	BodyLimit int64 `yaml:"body-limit" toml:"body-limit"`
+	// MaxVariables and MaxConstraints bound the size of the problems, 0 for no limit
+	MaxVariables   int `yaml:"max-variables" toml:"max-variables"`
+	MaxConstraints int `yaml:"max-constraints" toml:"max-constraints"`
+	// RateLimit is the number of solve requests per second allowed to a client on
+	// average, after a burst of RateBurst requests, 0 for no limit
+	RateLimit float64 `yaml:"rate-limit" toml:"rate-limit"`
+	RateBurst int     `yaml:"rate-burst" toml:"rate-burst"`
+	// TrustedProxies are the addresses or CIDRs of the proxies whose
+	// X-Forwarded-For and X-Real-IP headers give the address of the client, none
+	// by default so a client cannot choose the address its rate is limited by
+	TrustedProxies []string `yaml:"trusted-proxies" toml:"trusted-proxies"`
+	// MaxSolves is the number of requests solving at the same time, 0 for no limit
+	MaxSolves       int      `yaml:"max-solves" toml:"max-solves"`
+	SolveTimeLimit  duration `yaml:"solve-time-limit" toml:"solve-time-limit"`
+	SolveNodeLimit  int      `yaml:"solve-node-limit" toml:"solve-node-limit"`
+	MaxNodeTableaux int      `yaml:"max-node-tableaux" toml:"max-node-tableaux"`
+	Workers         int      `yaml:"workers" toml:"workers"`
+	MaxBatch        int      `yaml:"max-batch" toml:"max-batch"`
+	BatchWorkers    int      `yaml:"batch-workers" toml:"batch-workers"`
+	JobWorkers      int      `yaml:"job-workers" toml:"job-workers"`
+	JobQueue        int      `yaml:"job-queue" toml:"job-queue"`
+	JobTimeLimit    duration `yaml:"job-time-limit" toml:"job-time-limit"`
+	JobTTL          duration `yaml:"job-ttl" toml:"job-ttl"`
+	History         string   `yaml:"history" toml:"history"`
+	HistorySize     int      `yaml:"history-size" toml:"history-size"`
+	CORSOrigins     []string `yaml:"cors-origins" toml:"cors-origins"`
+	ShutdownTimeout duration `yaml:"shutdown-timeout" toml:"shutdown-timeout"`
+	// LogLevel is trace, debug, info, warn or error, the solver pivots are logged
+	// at trace level, the tableaux and nodes at debug level
+	LogLevel  string `yaml:"log-level" toml:"log-level"`
+	LogFormat string `yaml:"log-format" toml:"log-format"`
+}
+
+// duration reads a time.Duration written like 30s or 5m in the config files
+type duration struct {
+	time.Duration
+}
+
+func (d *duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	d.Duration = parsed
+	return nil
+}
+
+func defaultConfig() *config {
+	return &config{
+		Addr:            ":8080",
+		Mode:            gin.ReleaseMode,
+		BodyLimit:       1 << 20,
+		MaxVariables:    200,
+		MaxConstraints:  500,
+		RateLimit:       5,
+		RateBurst:       20,
+		MaxSolves:       runtime.NumCPU(),
+		SolveTimeLimit:  duration{30 * time.Second},
+		MaxNodeTableaux: 100,
+		Workers:         1,
+		MaxBatch:        100,
+		BatchWorkers:    runtime.NumCPU(),
+		JobWorkers:      2,
+		JobQueue:        100,
+		JobTimeLimit:    duration{10 * time.Minute},
+		JobTTL:          duration{jobs.DefaultTTL},
+		HistorySize:     200,
+		ShutdownTimeout: duration{10 * time.Second},
+		LogLevel:        "info",
+		LogFormat:       "text",
+	}
+}
+
+// loadConfig builds the configuration of serve from args, the environment and
+// the config file given by -config or PNLE_CONFIG
+func loadConfig(args []string) (*config, error) {
+	c := defaultConfig()
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	path := flags.String("config", os.Getenv(envPrefix+"CONFIG"), "YAML or TOML config file")
+	flags.StringVar(&c.Addr, "addr", c.Addr, "address the server listens on")
+	flags.StringVar(&c.GRPCAddr, "grpc-addr", c.GRPCAddr, "address the gRPC server listens on, empty to run none")
+	flags.StringVar(&c.TLSCert, "tls-cert", c.TLSCert, "TLS certificate file, the servers use TLS when it is set with -tls-key")
+	flags.StringVar(&c.TLSKey, "tls-key", c.TLSKey, "TLS private key file")
+	flags.StringVar(&c.Mode, "mode", c.Mode, "gin mode: debug, release or test")
+	flags.Int64Var(&c.BodyLimit, "body-limit", c.BodyLimit, "largest request body in bytes")
+	flags.IntVar(&c.MaxVariables, "max-variables", c.MaxVariables, "largest number of variables of a problem, 0 for no limit")
+	flags.IntVar(&c.MaxConstraints, "max-constraints", c.MaxConstraints, "largest number of constraints of a problem, 0 for no limit")
+	flags.Float64Var(&c.RateLimit, "rate-limit", c.RateLimit, "solve requests per second allowed to a client, 0 for no limit")
+	flags.IntVar(&c.RateBurst, "rate-burst", c.RateBurst, "solve requests a client can send at once")
+	flags.Func("trusted-proxies", "comma separated addresses or CIDRs of the proxies trusted to give the client address", func(value string) error {
+		c.TrustedProxies = splitList(value)
+		return nil
+	})
+	flags.IntVar(&c.MaxSolves, "max-solves", c.MaxSolves, "number of requests solving at the same time, 0 for no limit")
+	flags.DurationVar(&c.SolveTimeLimit.Duration, "solve-time-limit", c.SolveTimeLimit.Duration, "time limit of the solves of a request")
+	flags.IntVar(&c.SolveNodeLimit, "solve-node-limit", c.SolveNodeLimit, "node limit of the solves, 0 for none")
+	flags.IntVar(&c.MaxNodeTableaux, "max-node-tableaux", c.MaxNodeTableaux, "number of branch and bound nodes whose tableaux are answered by /solve, 0 for no limit")
+	flags.IntVar(&c.Workers, "workers", c.Workers, "number of goroutines of each branch and bound, 0 for one per CPU")
+	flags.IntVar(&c.MaxBatch, "max-batch", c.MaxBatch, "largest number of problems of a batch, 0 for no limit")
+	flags.IntVar(&c.BatchWorkers, "batch-workers", c.BatchWorkers, "number of problems of a batch solved at the same time")
+	flags.IntVar(&c.JobWorkers, "job-workers", c.JobWorkers, "number of jobs solved at the same time")
+	flags.IntVar(&c.JobQueue, "job-queue", c.JobQueue, "number of jobs waiting for a worker before new jobs are refused")
+	flags.DurationVar(&c.JobTimeLimit.Duration, "job-time-limit", c.JobTimeLimit.Duration, "time limit of the solves of the jobs")
+	flags.DurationVar(&c.JobTTL.Duration, "job-ttl", c.JobTTL.Duration, "how long a finished job is kept")
+	flags.StringVar(&c.History, "history", c.History, "file keeping the solved problems, empty to keep none")
+	flags.IntVar(&c.HistorySize, "history-size", c.HistorySize, "number of solved problems kept, 0 to keep all of them")
+	flags.Func("cors-origins", "comma separated origins allowed to call the API, * for any", func(value string) error {
+		c.CORSOrigins = splitList(value)
+		return nil
+	})
+	flags.DurationVar(&c.ShutdownTimeout.Duration, "shutdown-timeout", c.ShutdownTimeout.Duration, "time given to the requests in progress to end on shutdown")
+	flags.StringVar(&c.LogLevel, "log-level", c.LogLevel, "log level: trace, debug, info, warn or error")
+	flags.StringVar(&c.LogFormat, "log-format", c.LogFormat, "log format: text or json")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if *path != "" {
+		if err := c.readFile(*path); err != nil {
+			return nil, err
+		}
+	}
+	var err error
+	flags.VisitAll(func(f *flag.Flag) {
+		name := envPrefix + strings.ToUpper(strings.ReplaceAll(f.Name, "-", "_"))
+		if value, ok := os.LookupEnv(name); ok && f.Name != "config" && err == nil {
+			if setErr := f.Value.Set(value); setErr != nil {
+				err = fmt.Errorf("invalid value %q for %s: %w", value, name, setErr)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the flags are parsed again so they override the file and the environment
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if c.Workers == 0 {
+		c.Workers = runtime.NumCPU()
+	}
+	return c, c.validate()
+}
+
+// readFile reads a config file, its format is given by its extension
+func (c *config) readFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, c)
+	case ".toml":
+		err = toml.Unmarshal(content, c)
+	default:
+		return fmt.Errorf("unknown config file format %q, expected .yaml, .yml or .toml", filepath.Ext(path))
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+func (c *config) validate() error {
+	switch c.Mode {
+	case gin.DebugMode, gin.ReleaseMode, gin.TestMode:
+	default:
+		return fmt.Errorf("unknown gin mode %q", c.Mode)
+	}
+	if (c.TLSCert == "") != (c.TLSKey == "") {
+		return errors.New("the TLS certificate and key must be given together")
+	}
+	if c.BodyLimit <= 0 {
+		return errors.New("the body limit must be positive")
+	}
+	if c.RateLimit > 0 && c.RateBurst < 1 {
+		return errors.New("the rate burst must be at least 1")
+	}
+	if c.MaxNodeTableaux < 0 {
+		return errors.New("the number of node tableaux cannot be negative")
+	}
+	if c.Workers < 0 {
+		return errors.New("the number of workers cannot be negative")
+	}
+	if c.BatchWorkers < 1 {
+		return errors.New("at least one batch worker is needed")
+	}
+	if c.JobWorkers < 1 {
+		return errors.New("at least one job worker is needed")
+	}
+	return nil
+}
+
+// solveOptions are the options of the solves of the requests, logging to
+// logger. A new value is returned each time so the observers can be appended to
+func (c *config) solveOptions(logger *slog.Logger) lp.SolveOptions {
+	options := lp.DefaultSolveOptions()
+	options.Workers = c.Workers
+	options.TimeLimit = c.SolveTimeLimit.Duration
+	options.NodeLimit = c.SolveNodeLimit
+	options.Logger = logger
+	return options
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestLoadConfigWorkers(t *testing.T) {
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{nil, 1},
+		{[]string{"-workers", "3"}, 3},
+		{[]string{"-workers", "0"}, runtime.NumCPU()},
+	}
+	for _, test := range tests {
+		c, err := loadConfig(test.args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.Workers != test.want {
+			t.Errorf("loadConfig(%q) has %d workers, want %d", test.args, c.Workers, test.want)
+		}
+	}
+	if _, err := loadConfig([]string{"-workers", "-1"}); err == nil {
+		t.Error("loadConfig() accepted -1 workers")
+	}
+}
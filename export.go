@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"pnle/lp"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportFormat is a document written by POST /solve/export
+type exportFormat struct {
+	contentType string
+	extension   string
+	write       func(problem *lp.IntegerLineaProblem, ctx *gin.Context) error
+}
+
+// exportFormats are the documents of POST /solve/export, by name
+var exportFormats = map[string]exportFormat{
+	"latex": {"application/x-latex", "tex", func(problem *lp.IntegerLineaProblem, ctx *gin.Context) error {
+		_, err := ctx.Writer.WriteString(problem.LaTeX())
+		return err
+	}},
+	"csv": {mimeCSV, "csv", func(problem *lp.IntegerLineaProblem, ctx *gin.Context) error {
+		return problem.WriteCSV(ctx.Writer)
+	}},
+	"html": {gin.MIMEHTML, "html", func(problem *lp.IntegerLineaProblem, ctx *gin.Context) error {
+		report, err := problem.HTML()
+		if err != nil {
+			return err
+		}
+		_, err = ctx.Writer.WriteString(report)
+		return err
+	}},
+}
+
+// solveExport solves a problem like /solve and answers with the document of
+// the ?format query, latex, csv or html, as an attachment to hand in
+func solveExport(c *config) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		name := ctx.DefaultQuery("format", "latex")
+		format, ok := exportFormats[name]
+		if !ok {
+			ctx.JSON(400, gin.H{"error": fmt.Sprintf("unknown export format %q, expected latex, csv or html", name)})
+			return
+		}
+		problem, _, ok := bindProblemString(ctx, c)
+		if !ok {
+			return
+		}
+		logger := requestLogger(ctx)
+		if _, err := problem.SolveContext(ctx.Request.Context(), c.solveOptions(logger)); err != nil {
+			logger.Warn("solve abandoned", "error", err)
+			return
+		}
+		ctx.Status(200)
+		ctx.Header("Content-Type", format.contentType+"; charset=utf-8")
+		ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="solution.%s"`, format.extension))
+		if err := format.write(problem, ctx); err != nil {
+			logger.Warn("export not sent", "error", err)
+		}
+	}
+}
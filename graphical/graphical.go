@@ -0,0 +1,226 @@
+package graphical
+
+import (
+	"errors"
+	"math"
+	"pnle/lp"
+	"sort"
+)
+
+const tolerance = 1e-7
+
+// maxLatticeSize is the largest plot window, in units of x1 and x2, for which
+// the integer lattice points are listed
+const maxLatticeSize = 60
+
+type Point struct {
+	X1 float64 `json:"x1"`
+	X2 float64 `json:"x2"`
+}
+
+type Vertex struct {
+	Point
+	// Value is the objective function evaluated at the vertex
+	Value   float64 `json:"value"`
+	Optimal bool    `json:"optimal"`
+}
+
+// Solution is the result of the graphical method on a two variable problem
+type Solution struct {
+	problem *lp.LinearProblem
+	// region is the feasible region clipped to the plot window
+	region []Vertex
+	// Vertices are the corners of the feasible region, in counter-clockwise order
+	Vertices []Vertex `json:"vertices"`
+	Feasible bool     `json:"feasible"`
+	// Unbounded is set when the objective improves without limit on the feasible region
+	Unbounded bool    `json:"unbounded"`
+	Optimum   *Vertex `json:"optimum,omitempty"`
+	// IntegerPoints are the feasible lattice points, they are not listed when
+	// the plot window is larger than maxLatticeSize
+	IntegerPoints  []Point `json:"integerPoints,omitempty"`
+	IntegerOptimum *Point  `json:"integerOptimum,omitempty"`
+	// Size is the side of the plot window, which starts at the origin
+	Size float64 `json:"size"`
+}
+
+// line is a1*x1 + a2*x2 = b
+type line struct {
+	a1, a2, b float64
+}
+
+var ErrNotTwoVariables = errors.New("the graphical method needs exactly two decision variables")
+
+// Solve applies the graphical method to problem, x1 >= 0 and x2 >= 0 are implied
+func Solve(problem *lp.LinearProblem) (*Solution, error) {
+	if len(problem.ObjectiveFunction) != 2 {
+		return nil, ErrNotTwoVariables
+	}
+	solution := &Solution{problem: problem}
+	lines := []line{{1, 0, 0}, {0, 1, 0}}
+	for i, constraint := range problem.Constraints {
+		lines = append(lines, line{constraint[0], constraint[1], problem.Rhs[i]})
+	}
+
+	// the vertices of the feasible region are the feasible intersections of two lines
+	vertices := solution.intersections(lines)
+	solution.Size = windowSize(vertices, lines)
+	solution.Feasible = len(vertices) > 0
+	if !solution.Feasible {
+		return solution, nil
+	}
+	best := 0
+	for i := range vertices {
+		vertices[i].Value = solution.objective(vertices[i].Point)
+		if solution.isBetter(vertices[i].Value, vertices[best].Value) {
+			best = i
+		}
+	}
+
+	// the region is clipped to the plot window, a clipped corner better than
+	// every vertex means that the objective is unbounded
+	window := append([]line{{1, 0, solution.Size}, {0, 1, solution.Size}}, lines...)
+	solution.region = sortCounterClockwise(solution.intersections(window))
+	for _, corner := range solution.region {
+		if solution.isBetter(solution.objective(corner.Point), vertices[best].Value) {
+			solution.Unbounded = true
+			break
+		}
+	}
+	if !solution.Unbounded {
+		vertices[best].Optimal = true
+		optimum := vertices[best]
+		solution.Optimum = &optimum
+	}
+	solution.Vertices = sortCounterClockwise(vertices)
+
+	if solution.Size <= maxLatticeSize {
+		solution.integerPoints()
+	}
+	return solution, nil
+}
+
+func (solution *Solution) objective(point Point) float64 {
+	return solution.problem.ObjectiveFunction[0]*point.X1 + solution.problem.ObjectiveFunction[1]*point.X2
+}
+
+func (solution *Solution) isBetter(value, reference float64) bool {
+	if solution.problem.IsMaximization {
+		return value > reference+tolerance
+	}
+	return value < reference-tolerance
+}
+
+func (solution *Solution) isFeasible(point Point) bool {
+	if point.X1 < -tolerance || point.X2 < -tolerance {
+		return false
+	}
+	problem := solution.problem
+	for i, constraint := range problem.Constraints {
+		lhs := constraint[0]*point.X1 + constraint[1]*point.X2
+		slack := tolerance * math.Max(1, math.Abs(problem.Rhs[i]))
+		switch problem.ConstraintTypes[i] {
+		case "<=":
+			if lhs > problem.Rhs[i]+slack {
+				return false
+			}
+		case ">=":
+			if lhs < problem.Rhs[i]-slack {
+				return false
+			}
+		default:
+			if math.Abs(lhs-problem.Rhs[i]) > slack {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// intersections returns the distinct feasible intersections of every pair of lines
+func (solution *Solution) intersections(lines []line) []Vertex {
+	var vertices []Vertex
+	for i := 0; i < len(lines); i++ {
+		for j := i + 1; j < len(lines); j++ {
+			point, ok := intersect(lines[i], lines[j])
+			if !ok || !solution.isFeasible(point) || containsPoint(vertices, point) {
+				continue
+			}
+			vertices = append(vertices, Vertex{Point: point})
+		}
+	}
+	return vertices
+}
+
+func (solution *Solution) integerPoints() {
+	for x1 := 0.0; x1 <= solution.Size; x1++ {
+		for x2 := 0.0; x2 <= solution.Size; x2++ {
+			point := Point{x1, x2}
+			if !solution.isFeasible(point) {
+				continue
+			}
+			solution.IntegerPoints = append(solution.IntegerPoints, point)
+			if solution.Unbounded {
+				continue
+			}
+			if solution.IntegerOptimum == nil || solution.isBetter(solution.objective(point), solution.objective(*solution.IntegerOptimum)) {
+				optimum := point
+				solution.IntegerOptimum = &optimum
+			}
+		}
+	}
+}
+
+func intersect(l1, l2 line) (Point, bool) {
+	determinant := l1.a1*l2.a2 - l1.a2*l2.a1
+	if math.Abs(determinant) < tolerance {
+		return Point{}, false
+	}
+	return Point{
+		// adding 0 turns -0 into 0
+		X1: (l1.b*l2.a2-l1.a2*l2.b)/determinant + 0,
+		X2: (l1.a1*l2.b-l1.b*l2.a1)/determinant + 0,
+	}, true
+}
+
+func containsPoint(vertices []Vertex, point Point) bool {
+	for _, vertex := range vertices {
+		if math.Abs(vertex.X1-point.X1) < tolerance && math.Abs(vertex.X2-point.X2) < tolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// windowSize returns a plot window that holds every vertex and every axis intercept
+func windowSize(vertices []Vertex, lines []line) float64 {
+	size := 0.0
+	for _, vertex := range vertices {
+		size = math.Max(size, math.Max(vertex.X1, vertex.X2))
+	}
+	for _, l := range lines {
+		if l.a1 != 0 && l.b/l.a1 > 0 {
+			size = math.Max(size, l.b/l.a1)
+		}
+		if l.a2 != 0 && l.b/l.a2 > 0 {
+			size = math.Max(size, l.b/l.a2)
+		}
+	}
+	if size == 0 {
+		return 10
+	}
+	return math.Ceil(size * 1.2)
+}
+
+func sortCounterClockwise(vertices []Vertex) []Vertex {
+	var center Point
+	for _, vertex := range vertices {
+		center.X1 += vertex.X1 / float64(len(vertices))
+		center.X2 += vertex.X2 / float64(len(vertices))
+	}
+	sort.Slice(vertices, func(i, j int) bool {
+		return math.Atan2(vertices[i].X2-center.X2, vertices[i].X1-center.X1) <
+			math.Atan2(vertices[j].X2-center.X2, vertices[j].X1-center.X1)
+	})
+	return vertices
+}
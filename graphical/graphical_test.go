@@ -0,0 +1,113 @@
+package graphical
+
+import (
+	"errors"
+	"pnle/lp"
+	"strings"
+	"testing"
+)
+
+func solve(t *testing.T, problemString string) *Solution {
+	t.Helper()
+	problem, err := lp.ParseProblem(problemString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	solution, err := Solve(problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the plot is drawn in every case
+	if svg := solution.SVG(); !strings.HasPrefix(svg, "<svg") || !strings.HasSuffix(strings.TrimSpace(svg), "</svg>") {
+		t.Errorf("SVG() = %.60q, want an svg element", svg)
+	}
+	return solution
+}
+
+func TestSolveBounded(t *testing.T) {
+	solution := solve(t, "max 5 4\n6 4 <= 24\n1 2 <= 6")
+	if !solution.Feasible || solution.Unbounded {
+		t.Fatalf("feasible %v, unbounded %v, want a feasible bounded problem", solution.Feasible, solution.Unbounded)
+	}
+	want := []Point{{0, 0}, {4, 0}, {3, 1.5}, {0, 3}}
+	if len(solution.Vertices) != len(want) {
+		t.Fatalf("vertices = %v, want %v", solution.Vertices, want)
+	}
+	// the vertices are counter-clockwise, from any of them
+	start := 0
+	for i, vertex := range solution.Vertices {
+		if vertex.Point == want[0] {
+			start = i
+		}
+	}
+	for i, point := range want {
+		if vertex := solution.Vertices[(start+i)%len(want)]; vertex.Point != point {
+			t.Errorf("vertex %d = %v, want %v", i, vertex.Point, point)
+		}
+	}
+	if solution.Optimum == nil || solution.Optimum.Point != (Point{3, 1.5}) || solution.Optimum.Value != 21 {
+		t.Errorf("optimum = %v, want (3, 1.5) with 21", solution.Optimum)
+	}
+	optimal := 0
+	for _, vertex := range solution.Vertices {
+		if vertex.Optimal {
+			optimal++
+		}
+	}
+	if optimal != 1 {
+		t.Errorf("%d optimal vertices, want 1", optimal)
+	}
+	if solution.IntegerOptimum == nil || *solution.IntegerOptimum != (Point{4, 0}) {
+		t.Errorf("integer optimum = %v, want (4, 0)", solution.IntegerOptimum)
+	}
+	// (0,0) to (4,0), (0,1) to (3,1), (0,2) to (2,2) and (0,3)
+	if len(solution.IntegerPoints) != 13 {
+		t.Errorf("%d integer points, want 13", len(solution.IntegerPoints))
+	}
+}
+
+func TestSolveUnboundedRegion(t *testing.T) {
+	// the region is unbounded but the minimum is not
+	solution := solve(t, "min 1 1\n1 1 >= 2")
+	if !solution.Feasible || solution.Unbounded {
+		t.Fatalf("feasible %v, unbounded %v, want a feasible bounded problem", solution.Feasible, solution.Unbounded)
+	}
+	if solution.Optimum == nil || solution.Optimum.Value != 2 {
+		t.Errorf("optimum = %v, want the value 2", solution.Optimum)
+	}
+}
+
+func TestSolveUnbounded(t *testing.T) {
+	solution := solve(t, "max 1 1\n1 -1 <= 1")
+	if !solution.Feasible || !solution.Unbounded {
+		t.Fatalf("feasible %v, unbounded %v, want a feasible unbounded problem", solution.Feasible, solution.Unbounded)
+	}
+	if solution.Optimum != nil || solution.IntegerOptimum != nil {
+		t.Errorf("optimum = %v, integer optimum = %v, want none", solution.Optimum, solution.IntegerOptimum)
+	}
+	for _, vertex := range solution.Vertices {
+		if vertex.Optimal {
+			t.Errorf("vertex %v is optimal", vertex.Point)
+		}
+	}
+}
+
+func TestSolveInfeasible(t *testing.T) {
+	solution := solve(t, "max 1 1\n1 1 <= 1\n1 1 >= 2")
+	if solution.Feasible || solution.Unbounded {
+		t.Errorf("feasible %v, unbounded %v, want an infeasible problem", solution.Feasible, solution.Unbounded)
+	}
+	if len(solution.Vertices) != 0 || solution.Optimum != nil || len(solution.IntegerPoints) != 0 {
+		t.Errorf("vertices %v, optimum %v, integer points %v, want none", solution.Vertices, solution.Optimum, solution.IntegerPoints)
+	}
+}
+
+func TestSolveNotTwoVariables(t *testing.T) {
+	problem, err := lp.ParseProblem("max 1 1 1\n1 1 1 <= 3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Solve(problem); !errors.Is(err, ErrNotTwoVariables) {
+		t.Errorf("Solve() of 3 variables error = %v, want ErrNotTwoVariables", err)
+	}
+}
@@ -0,0 +1,147 @@
+package graphical
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strings"
+)
+
+const (
+	svgSize   = 480.0
+	svgMargin = 40.0
+	// svgTicks is the number of graduations on each axis
+	svgTicks = 5
+)
+
+// constraintColors are used in turn for the constraint lines
+var constraintColors = []string{"#2e86c1", "#cb4335", "#28b463", "#af7ac5", "#d68910", "#17a589"}
+
+// SVG renders the constraint lines, the feasible region, the iso-profit line
+// through the optimum and the feasible integer points
+func (solution *Solution) SVG() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%v" height="%v" viewBox="0 0 %v %v" font-family="Arial" font-size="11">`,
+		svgSize, svgSize, svgSize, svgSize))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf(`<defs><clipPath id="plot"><rect x="%v" y="%v" width="%v" height="%v"/></clipPath></defs>`,
+		svgMargin, svgMargin, svgSize-2*svgMargin, svgSize-2*svgMargin))
+	sb.WriteString("\n")
+	solution.writeAxes(&sb)
+
+	if len(solution.region) > 0 {
+		points := make([]string, len(solution.region))
+		for i, corner := range solution.region {
+			x, y := solution.project(corner.Point)
+			points[i] = fmt.Sprintf("%.2f,%.2f", x, y)
+		}
+		sb.WriteString(fmt.Sprintf(`<polygon points="%s" fill="#abebc6" fill-opacity="0.6" stroke="#239b56" stroke-width="2"/>`,
+			strings.Join(points, " ")))
+		sb.WriteString("\n")
+	}
+
+	problem := solution.problem
+	for i, constraint := range problem.Constraints {
+		color := constraintColors[i%len(constraintColors)]
+		label := html.EscapeString(fmt.Sprintf("%vx1 + %vx2 %s %v", constraint[0], constraint[1], problem.ConstraintTypes[i], problem.Rhs[i]))
+		solution.writeLine(&sb, line{constraint[0], constraint[1], problem.Rhs[i]}, color, "", label)
+		sb.WriteString(fmt.Sprintf(`<text x="%v" y="%v" fill="%s">%s</text>`,
+			svgMargin+8, svgMargin+14*float64(i+1), color, label))
+		sb.WriteString("\n")
+	}
+
+	for _, point := range solution.IntegerPoints {
+		x, y := solution.project(point)
+		sb.WriteString(fmt.Sprintf(`<circle cx="%.2f" cy="%.2f" r="2" fill="#566573"/>`, x, y))
+		sb.WriteString("\n")
+	}
+
+	if solution.Optimum != nil {
+		c := problem.ObjectiveFunction
+		label := fmt.Sprintf("Z = %v", round(solution.Optimum.Value))
+		solution.writeLine(&sb, line{c[0], c[1], solution.Optimum.Value}, "#7d3c98", "6,4", label)
+		x, y := solution.project(solution.Optimum.Point)
+		sb.WriteString(fmt.Sprintf(`<circle cx="%.2f" cy="%.2f" r="5" fill="#7d3c98"><title>(%v, %v) %s</title></circle>`,
+			x, y, round(solution.Optimum.X1), round(solution.Optimum.X2), label))
+		sb.WriteString("\n")
+	}
+	if solution.IntegerOptimum != nil {
+		x, y := solution.project(*solution.IntegerOptimum)
+		sb.WriteString(fmt.Sprintf(`<circle cx="%.2f" cy="%.2f" r="5" fill="none" stroke="#d35400" stroke-width="2"><title>integer optimum (%v, %v)</title></circle>`,
+			x, y, solution.IntegerOptimum.X1, solution.IntegerOptimum.X2))
+		sb.WriteString("\n")
+	}
+	sb.WriteString("</svg>\n")
+	return sb.String()
+}
+
+func (solution *Solution) writeAxes(sb *strings.Builder) {
+	origin := svgSize - svgMargin
+	sb.WriteString(fmt.Sprintf(`<line x1="%v" y1="%v" x2="%v" y2="%v" stroke="black"/>`, svgMargin, origin, svgSize-svgMargin, origin))
+	sb.WriteString(fmt.Sprintf(`<line x1="%v" y1="%v" x2="%v" y2="%v" stroke="black"/>`, svgMargin, origin, svgMargin, svgMargin))
+	sb.WriteString("\n")
+	for i := 0; i <= svgTicks; i++ {
+		value := solution.Size * float64(i) / svgTicks
+		offset := (svgSize - 2*svgMargin) * float64(i) / svgTicks
+		sb.WriteString(fmt.Sprintf(`<text x="%.2f" y="%v" text-anchor="middle">%v</text>`, svgMargin+offset, origin+16, round(value)))
+		sb.WriteString(fmt.Sprintf(`<text x="%v" y="%.2f" text-anchor="end">%v</text>`, svgMargin-6, origin-offset+4, round(value)))
+		sb.WriteString("\n")
+	}
+	sb.WriteString(fmt.Sprintf(`<text x="%v" y="%v" text-anchor="end">x1</text>`, svgSize-svgMargin, origin+32))
+	sb.WriteString(fmt.Sprintf(`<text x="%v" y="%v">x2</text>`, svgMargin-32, svgMargin-8))
+	sb.WriteString("\n")
+}
+
+// writeLine draws the part of l that crosses the plot window
+func (solution *Solution) writeLine(sb *strings.Builder, l line, color string, dash string, title string) {
+	from, to, ok := solution.clip(l)
+	if !ok {
+		return
+	}
+	x1, y1 := solution.project(from)
+	x2, y2 := solution.project(to)
+	sb.WriteString(fmt.Sprintf(`<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="%s" stroke-width="2" clip-path="url(#plot)"`,
+		x1, y1, x2, y2, color))
+	if dash != "" {
+		sb.WriteString(fmt.Sprintf(` stroke-dasharray="%s"`, dash))
+	}
+	sb.WriteString(fmt.Sprintf("><title>%s</title></line>\n", title))
+}
+
+// clip returns the two ends of l on the border of the plot window
+func (solution *Solution) clip(l line) (Point, Point, bool) {
+	size := solution.Size
+	border := []line{{1, 0, 0}, {1, 0, size}, {0, 1, 0}, {0, 1, size}}
+	var ends []Point
+	for _, side := range border {
+		point, ok := intersect(l, side)
+		if !ok || point.X1 < -tolerance || point.X1 > size+tolerance || point.X2 < -tolerance || point.X2 > size+tolerance {
+			continue
+		}
+		ends = append(ends, point)
+	}
+	if len(ends) < 2 {
+		return Point{}, Point{}, false
+	}
+	// the ends of the longest chord, corners are found twice
+	from, to := ends[0], ends[1]
+	for _, end := range ends[2:] {
+		if distance(from, end) > distance(from, to) {
+			to = end
+		}
+	}
+	return from, to, true
+}
+
+func (solution *Solution) project(point Point) (float64, float64) {
+	scale := (svgSize - 2*svgMargin) / solution.Size
+	return svgMargin + point.X1*scale, svgSize - svgMargin - point.X2*scale
+}
+
+func distance(p1, p2 Point) float64 {
+	return math.Hypot(p1.X1-p2.X1, p1.X2-p2.X2)
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"pnle/grpcapi"
+	"pnle/grpcapi/solverpb"
+	"pnle/internal/transport"
+	"pnle/lp"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+)
+
+// grpcServer is the gRPC server running next to the HTTP server
+type grpcServer struct {
+	server   *grpc.Server
+	listener net.Listener
+}
+
+// newGRPCServer listens on the gRPC address of c, the server has the limits of
+// the HTTP API apart from the rate limit, shares solves with it and uses the
+// TLS certificate of c
+func newGRPCServer(c *config, logger *slog.Logger, solves *transport.Semaphore) (*grpcServer, error) {
+	var options []grpc.ServerOption
+	if c.TLSCert != "" {
+		creds, err := credentials.NewServerTLSFromFile(c.TLSCert, c.TLSKey)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, grpc.Creds(creds))
+	}
+	options = append(options, grpc.MaxRecvMsgSize(int(c.BodyLimit)))
+	listener, err := net.Listen("tcp", c.GRPCAddr)
+	if err != nil {
+		return nil, err
+	}
+	server := grpc.NewServer(options...)
+	solverpb.RegisterSolverServer(server, grpcapi.NewServer(func(logger *slog.Logger) lp.SolveOptions {
+		return c.solveOptions(logger)
+	}, logger, grpcapi.Limits{
+		MaxVariables:   c.MaxVariables,
+		MaxConstraints: c.MaxConstraints,
+		Solves:         solves,
+	}))
+	// let tools like grpcurl list the services
+	reflection.Register(server)
+	return &grpcServer{server: server, listener: listener}, nil
+}
+
+func (s *grpcServer) serve() error {
+	return s.server.Serve(s.listener)
+}
+
+// shutdown waits for the calls in progress until ctx is done, then cancels them
+func (s *grpcServer) shutdown(ctx context.Context) {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.server.Stop()
+	}
+}
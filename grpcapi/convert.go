@@ -0,0 +1,104 @@
+package grpcapi
+
+import (
+	"pnle/api"
+	"pnle/grpcapi/solverpb"
+	"pnle/lp"
+)
+
+var (
+	variableTypes = map[solverpb.VariableType]string{
+		solverpb.VariableType_VARIABLE_TYPE_INTEGER:    api.VariableInteger,
+		solverpb.VariableType_VARIABLE_TYPE_CONTINUOUS: api.VariableContinuous,
+		solverpb.VariableType_VARIABLE_TYPE_BINARY:     api.VariableBinary,
+	}
+	// an unspecified sense or constraint type is left empty, so the validation
+	// of the model refuses it
+	senses = map[solverpb.Sense]string{
+		solverpb.Sense_SENSE_MAX: "max",
+		solverpb.Sense_SENSE_MIN: "min",
+	}
+	constraintTypes = map[solverpb.ConstraintType]string{
+		solverpb.ConstraintType_CONSTRAINT_TYPE_LESS_EQUAL:    "<=",
+		solverpb.ConstraintType_CONSTRAINT_TYPE_GREATER_EQUAL: ">=",
+		solverpb.ConstraintType_CONSTRAINT_TYPE_EQUAL:         "=",
+	}
+	statuses = map[lp.SolveStatus]solverpb.Status{
+		lp.StatusOptimal:       solverpb.Status_STATUS_OPTIMAL,
+		lp.StatusInfeasible:    solverpb.Status_STATUS_INFEASIBLE,
+		lp.StatusUnbounded:     solverpb.Status_STATUS_UNBOUNDED,
+		lp.StatusGapLimit:      solverpb.Status_STATUS_GAP_LIMIT,
+		lp.StatusNodeLimit:     solverpb.Status_STATUS_NODE_LIMIT,
+		lp.StatusTimeLimit:     solverpb.Status_STATUS_TIME_LIMIT,
+		lp.StatusSolutionLimit: solverpb.Status_STATUS_SOLUTION_LIMIT,
+		lp.StatusCanceled:      solverpb.Status_STATUS_CANCELED,
+	}
+)
+
+// newProblem converts a problem to the model of the JSON API
+func newProblem(model *solverpb.Problem) *api.Problem {
+	problem := &api.Problem{
+		Name: model.GetName(),
+		Objective: api.Objective{
+			Sense:        senses[model.GetObjective().GetSense()],
+			Coefficients: coefficients(model.GetObjective().GetCoefficients()),
+		},
+	}
+	for _, variable := range model.GetVariables() {
+		problem.Variables = append(problem.Variables, api.Variable{
+			Name:  variable.GetName(),
+			Type:  variableTypes[variable.GetType()],
+			Lower: variable.Lower,
+			Upper: variable.Upper,
+		})
+	}
+	for _, constraint := range model.GetConstraints() {
+		problem.Constraints = append(problem.Constraints, api.Constraint{
+			Name:         constraint.GetName(),
+			Coefficients: coefficients(constraint.GetCoefficients()),
+			Type:         constraintTypes[constraint.GetType()],
+			Rhs:          constraint.GetRhs(),
+		})
+	}
+	return problem
+}
+
+// coefficients returns an empty map for a missing map, which the model requires
+func coefficients(values map[string]float64) map[string]float64 {
+	if values == nil {
+		return map[string]float64{}
+	}
+	return values
+}
+
+func newOptions(options *solverpb.Options) *api.Options {
+	if options == nil {
+		return nil
+	}
+	return &api.Options{
+		Workers:           int(options.GetWorkers()),
+		Deterministic:     options.GetDeterministic(),
+		TimeLimitSeconds:  options.GetTimeLimitSeconds(),
+		NodeLimit:         int(options.GetNodeLimit()),
+		SolutionLimit:     int(options.GetSolutionLimit()),
+		RelativeGap:       options.GetRelativeGap(),
+		DisableHeuristics: options.GetDisableHeuristics(),
+	}
+}
+
+func newResult(result *api.Result) *solverpb.SolveResult {
+	return &solverpb.SolveResult{
+		Name:      result.Name,
+		Status:    statuses[result.Status],
+		Objective: result.Objective,
+		Values:    result.Values,
+		Duals:     result.Duals,
+		Statistics: &solverpb.Statistics{
+			Nodes:            int32(result.Statistics.Nodes),
+			DualBound:        result.Statistics.DualBound,
+			AbsoluteGap:      result.Statistics.AbsoluteGap,
+			RelativeGap:      result.Statistics.RelativeGap,
+			SolveTimeSeconds: result.Statistics.SolveTimeSeconds,
+		},
+	}
+}
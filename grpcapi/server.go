@@ -0,0 +1,173 @@
+// Package grpcapi serves the Solver gRPC service defined in solverpb/solver.proto,
+// it solves the problems of the model of the JSON API with the same solvers
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"pnle/api"
+	"pnle/grpcapi/solverpb"
+	"pnle/internal/transport"
+	"pnle/lp"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative solverpb/solver.proto
+
+// requestIDKey is the metadata key of the ID of a call, it is kept when the
+// client sends one
+const requestIDKey = "x-request-id"
+
+// Limits bounds the problems and the solves of a server, a zero value disables
+// a limit
+type Limits struct {
+	MaxVariables   int
+	MaxConstraints int
+	// Solves bounds the calls solving at the same time, it is shared with the
+	// HTTP server so both count against the same limit
+	Solves *transport.Semaphore
+}
+
+// Server implements solverpb.SolverServer
+type Server struct {
+	solverpb.UnimplementedSolverServer
+	options func(logger *slog.Logger) lp.SolveOptions
+	logger  *slog.Logger
+	limits  Limits
+}
+
+// NewServer returns a server solving with the options returned by options for
+// the logger of each call, the options of the requests apply within them
+func NewServer(options func(logger *slog.Logger) lp.SolveOptions, logger *slog.Logger, limits Limits) *Server {
+	return &Server{options: options, logger: logger, limits: limits}
+}
+
+func (s *Server) Solve(ctx context.Context, request *solverpb.SolveRequest) (*solverpb.SolveResult, error) {
+	logger, done := s.call(ctx, "Solve")
+	problem, err := s.problem(request)
+	var result *solverpb.SolveResult
+	if err == nil {
+		result, err = s.solve(ctx, logger, problem, request.GetRelaxation(), nil)
+	}
+	done(err)
+	return result, err
+}
+
+func (s *Server) SolveStream(request *solverpb.SolveRequest, stream grpc.ServerStreamingServer[solverpb.SolveEvent]) error {
+	ctx := stream.Context()
+	logger, done := s.call(ctx, "SolveStream")
+	problem, err := s.problem(request)
+	if err != nil {
+		done(err)
+		return err
+	}
+	names := make([]string, len(problem.Variables))
+	for j, variable := range problem.Variables {
+		names[j] = variable.Name
+	}
+	observer := transport.NewObserver(ctx)
+	var result *solverpb.SolveResult
+	go func() {
+		defer observer.Close()
+		result, err = s.solve(ctx, logger, problem, request.GetRelaxation(), observer)
+	}()
+	for event := range observer.Events() {
+		if sendErr := stream.Send(newSolveEvent(event, names)); sendErr != nil {
+			// let the solver return now that the client is gone
+			observer.Drain()
+			done(sendErr)
+			return sendErr
+		}
+	}
+	// the events channel is closed once result and err are set
+	if err == nil {
+		err = stream.Send(&solverpb.SolveEvent{Event: &solverpb.SolveEvent_Result{Result: result}})
+	}
+	done(err)
+	return err
+}
+
+// call gives a call an ID and a logger carrying it, done logs the call
+func (s *Server) call(ctx context.Context, method string) (logger *slog.Logger, done func(err error)) {
+	id := transport.NewRequestID()
+	if values := metadata.ValueFromIncomingContext(ctx, requestIDKey); len(values) > 0 && values[0] != "" && len(values[0]) <= 64 {
+		id = values[0]
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id))
+	logger = s.logger.With("request_id", id)
+	start := time.Now()
+	return logger, func(err error) {
+		logger.Info("grpc call", "method", method, "code", status.Code(err).String(), "duration", time.Since(start))
+	}
+}
+
+// solve solves problem, or only its linear relaxation, sending the solver
+// events to observer unless it is nil
+func (s *Server) solve(ctx context.Context, logger *slog.Logger, problem *api.Problem, relaxation bool, observer *transport.Observer) (*solverpb.SolveResult, error) {
+	if s.limits.Solves.TryAcquire(1) == 0 {
+		return nil, status.Error(codes.ResourceExhausted, "too many solves in progress, retry later")
+	}
+	defer s.limits.Solves.Release(1)
+	options := s.options(logger)
+	if observer != nil {
+		options.Observers = append(options.Observers, observer)
+	}
+	var result *api.Result
+	var err error
+	if relaxation {
+		result, err = problem.SolveRelaxation(ctx, options)
+	} else {
+		result, err = problem.Solve(ctx, options)
+	}
+	if err != nil {
+		logger.Warn("solve abandoned", "error", err)
+		return nil, status.FromContextError(err).Err()
+	}
+	return newResult(result), nil
+}
+
+// problem reads the problem of a request, it fails with InvalidArgument when
+// the problem is not valid and ResourceExhausted when it is over the limits
+func (s *Server) problem(request *solverpb.SolveRequest) (*api.Problem, error) {
+	var problem *api.Problem
+	switch p := request.GetProblem().(type) {
+	case *solverpb.SolveRequest_Model:
+		problem = newProblem(p.Model)
+	case *solverpb.SolveRequest_Text:
+		parsed, err := lp.ParseProblem(p.Text)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		problem = api.NewProblem(parsed)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "the request has no problem")
+	}
+	problem.Options = newOptions(request.GetOptions())
+	if err := binding.Validator.ValidateStruct(problem); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := problem.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.checkSize(problem); err != nil {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return problem, nil
+}
+
+func (s *Server) checkSize(problem *api.Problem) error {
+	switch {
+	case s.limits.MaxVariables > 0 && len(problem.Variables) > s.limits.MaxVariables:
+		return fmt.Errorf("the problem has %d variables, the limit is %d", len(problem.Variables), s.limits.MaxVariables)
+	case s.limits.MaxConstraints > 0 && len(problem.Constraints) > s.limits.MaxConstraints:
+		return fmt.Errorf("the problem has %d constraints, the limit is %d", len(problem.Constraints), s.limits.MaxConstraints)
+	}
+	return nil
+}
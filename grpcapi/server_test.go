@@ -0,0 +1,142 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"pnle/grpcapi/solverpb"
+	"pnle/internal/transport"
+	"pnle/lp"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const problemText = "max 5 4\n6 4 <= 24\n1 2 <= 6"
+
+// dial serves s in memory and returns a client connected to it
+func dial(t *testing.T, s *Server) solverpb.SolverClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	solverpb.RegisterSolverServer(server, s)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return solverpb.NewSolverClient(conn)
+}
+
+func newTestServer(limits Limits) *Server {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewServer(func(logger *slog.Logger) lp.SolveOptions {
+		options := lp.DefaultSolveOptions()
+		options.Workers = 1
+		options.Logger = logger
+		return options
+	}, logger, limits)
+}
+
+func TestSolve(t *testing.T) {
+	client := dial(t, newTestServer(Limits{}))
+	result, err := client.Solve(context.Background(), &solverpb.SolveRequest{
+		Problem: &solverpb.SolveRequest_Text{Text: problemText},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.GetStatus() != solverpb.Status_STATUS_OPTIMAL || result.GetObjective() != 20 {
+		t.Errorf("Solve() = %s with objective %v, want optimal with 20", result.GetStatus(), result.GetObjective())
+	}
+	if values := result.GetValues(); values["x1"] != 4 || values["x2"] != 0 {
+		t.Errorf("values = %v, want x1 = 4 and x2 = 0", values)
+	}
+
+	relaxation, err := client.Solve(context.Background(), &solverpb.SolveRequest{
+		Problem:    &solverpb.SolveRequest_Text{Text: problemText},
+		Relaxation: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if relaxation.GetObjective() != 21 {
+		t.Errorf("relaxation objective = %v, want 21", relaxation.GetObjective())
+	}
+}
+
+func TestSolveInvalid(t *testing.T) {
+	client := dial(t, newTestServer(Limits{MaxVariables: 1}))
+	tests := []struct {
+		request *solverpb.SolveRequest
+		code    codes.Code
+	}{
+		{&solverpb.SolveRequest{}, codes.InvalidArgument},
+		{&solverpb.SolveRequest{Problem: &solverpb.SolveRequest_Text{Text: "max 1 x"}}, codes.InvalidArgument},
+		{&solverpb.SolveRequest{Problem: &solverpb.SolveRequest_Text{Text: problemText}}, codes.ResourceExhausted},
+	}
+	for _, test := range tests {
+		if _, err := client.Solve(context.Background(), test.request); status.Code(err) != test.code {
+			t.Errorf("Solve(%v) error = %v, want %s", test.request, err, test.code)
+		}
+	}
+}
+
+func TestSolveStream(t *testing.T) {
+	client := dial(t, newTestServer(Limits{}))
+	stream, err := client.SolveStream(context.Background(), &solverpb.SolveRequest{
+		Problem: &solverpb.SolveRequest_Text{Text: problemText},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []*solverpb.SolveEvent
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, event)
+	}
+	if len(events) < 2 {
+		t.Fatalf("received %d events, want progress events and the result", len(events))
+	}
+	for _, event := range events[:len(events)-1] {
+		if event.GetResult() != nil {
+			t.Error("a result was sent before the last event")
+		}
+	}
+	if result := events[len(events)-1].GetResult(); result == nil || result.GetObjective() != 20 {
+		t.Errorf("last event = %v, want the result with objective 20", events[len(events)-1])
+	}
+}
+
+func TestSolvesShared(t *testing.T) {
+	solves := transport.NewSemaphore(1)
+	client := dial(t, newTestServer(Limits{Solves: solves}))
+	request := &solverpb.SolveRequest{Problem: &solverpb.SolveRequest_Text{Text: problemText}}
+
+	// a solve of the HTTP server holds the only slot
+	solves.TryAcquire(1)
+	if _, err := client.Solve(context.Background(), request); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Solve() during another solve error = %v, want ResourceExhausted", err)
+	}
+	solves.Release(1)
+	if _, err := client.Solve(context.Background(), request); err != nil {
+		t.Errorf("Solve() after the other solve error = %v", err)
+	}
+}
@@ -0,0 +1,1628 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: solverpb/solver.proto
+
+// pnle.solver.v1 solves linear and integer linear problems, its model is the
+// one of the JSON API at /api/v1/solve
+
+package solverpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VariableType int32
+
+const (
+	VariableType_VARIABLE_TYPE_INTEGER    VariableType = 0
+	VariableType_VARIABLE_TYPE_CONTINUOUS VariableType = 1
+	VariableType_VARIABLE_TYPE_BINARY     VariableType = 2
+)
+
+// Enum value maps for VariableType.
+var (
+	VariableType_name = map[int32]string{
+		0: "VARIABLE_TYPE_INTEGER",
+		1: "VARIABLE_TYPE_CONTINUOUS",
+		2: "VARIABLE_TYPE_BINARY",
+	}
+	VariableType_value = map[string]int32{
+		"VARIABLE_TYPE_INTEGER":    0,
+		"VARIABLE_TYPE_CONTINUOUS": 1,
+		"VARIABLE_TYPE_BINARY":     2,
+	}
+)
+
+func (x VariableType) Enum() *VariableType {
+	p := new(VariableType)
+	*p = x
+	return p
+}
+
+func (x VariableType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VariableType) Descriptor() protoreflect.EnumDescriptor {
+	return file_solverpb_solver_proto_enumTypes[0].Descriptor()
+}
+
+func (VariableType) Type() protoreflect.EnumType {
+	return &file_solverpb_solver_proto_enumTypes[0]
+}
+
+func (x VariableType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VariableType.Descriptor instead.
+func (VariableType) EnumDescriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{0}
+}
+
+type Sense int32
+
+const (
+	Sense_SENSE_UNSPECIFIED Sense = 0
+	Sense_SENSE_MAX         Sense = 1
+	Sense_SENSE_MIN         Sense = 2
+)
+
+// Enum value maps for Sense.
+var (
+	Sense_name = map[int32]string{
+		0: "SENSE_UNSPECIFIED",
+		1: "SENSE_MAX",
+		2: "SENSE_MIN",
+	}
+	Sense_value = map[string]int32{
+		"SENSE_UNSPECIFIED": 0,
+		"SENSE_MAX":         1,
+		"SENSE_MIN":         2,
+	}
+)
+
+func (x Sense) Enum() *Sense {
+	p := new(Sense)
+	*p = x
+	return p
+}
+
+func (x Sense) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Sense) Descriptor() protoreflect.EnumDescriptor {
+	return file_solverpb_solver_proto_enumTypes[1].Descriptor()
+}
+
+func (Sense) Type() protoreflect.EnumType {
+	return &file_solverpb_solver_proto_enumTypes[1]
+}
+
+func (x Sense) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Sense.Descriptor instead.
+func (Sense) EnumDescriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{1}
+}
+
+type ConstraintType int32
+
+const (
+	ConstraintType_CONSTRAINT_TYPE_UNSPECIFIED   ConstraintType = 0
+	ConstraintType_CONSTRAINT_TYPE_LESS_EQUAL    ConstraintType = 1
+	ConstraintType_CONSTRAINT_TYPE_GREATER_EQUAL ConstraintType = 2
+	ConstraintType_CONSTRAINT_TYPE_EQUAL         ConstraintType = 3
+)
+
+// Enum value maps for ConstraintType.
+var (
+	ConstraintType_name = map[int32]string{
+		0: "CONSTRAINT_TYPE_UNSPECIFIED",
+		1: "CONSTRAINT_TYPE_LESS_EQUAL",
+		2: "CONSTRAINT_TYPE_GREATER_EQUAL",
+		3: "CONSTRAINT_TYPE_EQUAL",
+	}
+	ConstraintType_value = map[string]int32{
+		"CONSTRAINT_TYPE_UNSPECIFIED":   0,
+		"CONSTRAINT_TYPE_LESS_EQUAL":    1,
+		"CONSTRAINT_TYPE_GREATER_EQUAL": 2,
+		"CONSTRAINT_TYPE_EQUAL":         3,
+	}
+)
+
+func (x ConstraintType) Enum() *ConstraintType {
+	p := new(ConstraintType)
+	*p = x
+	return p
+}
+
+func (x ConstraintType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConstraintType) Descriptor() protoreflect.EnumDescriptor {
+	return file_solverpb_solver_proto_enumTypes[2].Descriptor()
+}
+
+func (ConstraintType) Type() protoreflect.EnumType {
+	return &file_solverpb_solver_proto_enumTypes[2]
+}
+
+func (x ConstraintType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConstraintType.Descriptor instead.
+func (ConstraintType) EnumDescriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{2}
+}
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED    Status = 0
+	Status_STATUS_OPTIMAL        Status = 1
+	Status_STATUS_INFEASIBLE     Status = 2
+	Status_STATUS_UNBOUNDED      Status = 3
+	Status_STATUS_GAP_LIMIT      Status = 4
+	Status_STATUS_NODE_LIMIT     Status = 5
+	Status_STATUS_TIME_LIMIT     Status = 6
+	Status_STATUS_SOLUTION_LIMIT Status = 7
+	Status_STATUS_CANCELED       Status = 8
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_OPTIMAL",
+		2: "STATUS_INFEASIBLE",
+		3: "STATUS_UNBOUNDED",
+		4: "STATUS_GAP_LIMIT",
+		5: "STATUS_NODE_LIMIT",
+		6: "STATUS_TIME_LIMIT",
+		7: "STATUS_SOLUTION_LIMIT",
+		8: "STATUS_CANCELED",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":    0,
+		"STATUS_OPTIMAL":        1,
+		"STATUS_INFEASIBLE":     2,
+		"STATUS_UNBOUNDED":      3,
+		"STATUS_GAP_LIMIT":      4,
+		"STATUS_NODE_LIMIT":     5,
+		"STATUS_TIME_LIMIT":     6,
+		"STATUS_SOLUTION_LIMIT": 7,
+		"STATUS_CANCELED":       8,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_solverpb_solver_proto_enumTypes[3].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_solverpb_solver_proto_enumTypes[3]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{3}
+}
+
+type SolveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Problem:
+	//
+	//	*SolveRequest_Model
+	//	*SolveRequest_Text
+	Problem isSolveRequest_Problem `protobuf_oneof:"problem"`
+	// options apply to the solve within the limits of the server
+	Options *Options `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	// relaxation solves the linear relaxation of the problem with the simplex,
+	// ignoring the integrality of the variables
+	Relaxation    bool `protobuf:"varint,4,opt,name=relaxation,proto3" json:"relaxation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveRequest) Reset() {
+	*x = SolveRequest{}
+	mi := &file_solverpb_solver_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveRequest) ProtoMessage() {}
+
+func (x *SolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveRequest.ProtoReflect.Descriptor instead.
+func (*SolveRequest) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SolveRequest) GetProblem() isSolveRequest_Problem {
+	if x != nil {
+		return x.Problem
+	}
+	return nil
+}
+
+func (x *SolveRequest) GetModel() *Problem {
+	if x != nil {
+		if x, ok := x.Problem.(*SolveRequest_Model); ok {
+			return x.Model
+		}
+	}
+	return nil
+}
+
+func (x *SolveRequest) GetText() string {
+	if x != nil {
+		if x, ok := x.Problem.(*SolveRequest_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SolveRequest) GetRelaxation() bool {
+	if x != nil {
+		return x.Relaxation
+	}
+	return false
+}
+
+type isSolveRequest_Problem interface {
+	isSolveRequest_Problem()
+}
+
+type SolveRequest_Model struct {
+	Model *Problem `protobuf:"bytes,1,opt,name=model,proto3,oneof"`
+}
+
+type SolveRequest_Text struct {
+	// text is a problem in the text format of the web page, its variables are
+	// named x1, x2... and its constraints c1, c2...
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+func (*SolveRequest_Model) isSolveRequest_Problem() {}
+
+func (*SolveRequest_Text) isSolveRequest_Problem() {}
+
+type Problem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Variables     []*Variable            `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	Objective     *Objective             `protobuf:"bytes,3,opt,name=objective,proto3" json:"objective,omitempty"`
+	Constraints   []*Constraint          `protobuf:"bytes,4,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Problem) Reset() {
+	*x = Problem{}
+	mi := &file_solverpb_solver_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Problem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Problem) ProtoMessage() {}
+
+func (x *Problem) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Problem.ProtoReflect.Descriptor instead.
+func (*Problem) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Problem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Problem) GetVariables() []*Variable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Problem) GetObjective() *Objective {
+	if x != nil {
+		return x.Objective
+	}
+	return nil
+}
+
+func (x *Problem) GetConstraints() []*Constraint {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+type Variable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          VariableType           `protobuf:"varint,2,opt,name=type,proto3,enum=pnle.solver.v1.VariableType" json:"type,omitempty"`
+	Lower         *float64               `protobuf:"fixed64,3,opt,name=lower,proto3,oneof" json:"lower,omitempty"`
+	Upper         *float64               `protobuf:"fixed64,4,opt,name=upper,proto3,oneof" json:"upper,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Variable) Reset() {
+	*x = Variable{}
+	mi := &file_solverpb_solver_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variable) ProtoMessage() {}
+
+func (x *Variable) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variable.ProtoReflect.Descriptor instead.
+func (*Variable) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Variable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Variable) GetType() VariableType {
+	if x != nil {
+		return x.Type
+	}
+	return VariableType_VARIABLE_TYPE_INTEGER
+}
+
+func (x *Variable) GetLower() float64 {
+	if x != nil && x.Lower != nil {
+		return *x.Lower
+	}
+	return 0
+}
+
+func (x *Variable) GetUpper() float64 {
+	if x != nil && x.Upper != nil {
+		return *x.Upper
+	}
+	return 0
+}
+
+type Objective struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sense Sense                  `protobuf:"varint,1,opt,name=sense,proto3,enum=pnle.solver.v1.Sense" json:"sense,omitempty"`
+	// coefficients are keyed by variable name, missing variables have a zero
+	// coefficient
+	Coefficients  map[string]float64 `protobuf:"bytes,2,rep,name=coefficients,proto3" json:"coefficients,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Objective) Reset() {
+	*x = Objective{}
+	mi := &file_solverpb_solver_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Objective) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Objective) ProtoMessage() {}
+
+func (x *Objective) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Objective.ProtoReflect.Descriptor instead.
+func (*Objective) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Objective) GetSense() Sense {
+	if x != nil {
+		return x.Sense
+	}
+	return Sense_SENSE_UNSPECIFIED
+}
+
+func (x *Objective) GetCoefficients() map[string]float64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+type Constraint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name defaults to c1, c2... after the position of the constraint
+	Name          string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Coefficients  map[string]float64 `protobuf:"bytes,2,rep,name=coefficients,proto3" json:"coefficients,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Type          ConstraintType     `protobuf:"varint,3,opt,name=type,proto3,enum=pnle.solver.v1.ConstraintType" json:"type,omitempty"`
+	Rhs           float64            `protobuf:"fixed64,4,opt,name=rhs,proto3" json:"rhs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Constraint) Reset() {
+	*x = Constraint{}
+	mi := &file_solverpb_solver_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Constraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Constraint) ProtoMessage() {}
+
+func (x *Constraint) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Constraint.ProtoReflect.Descriptor instead.
+func (*Constraint) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Constraint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Constraint) GetCoefficients() map[string]float64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+func (x *Constraint) GetType() ConstraintType {
+	if x != nil {
+		return x.Type
+	}
+	return ConstraintType_CONSTRAINT_TYPE_UNSPECIFIED
+}
+
+func (x *Constraint) GetRhs() float64 {
+	if x != nil {
+		return x.Rhs
+	}
+	return 0
+}
+
+type Options struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Workers          int32                  `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	Deterministic    bool                   `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+	TimeLimitSeconds float64                `protobuf:"fixed64,3,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`
+	NodeLimit        int32                  `protobuf:"varint,4,opt,name=node_limit,json=nodeLimit,proto3" json:"node_limit,omitempty"`
+	SolutionLimit    int32                  `protobuf:"varint,5,opt,name=solution_limit,json=solutionLimit,proto3" json:"solution_limit,omitempty"`
+	RelativeGap      float64                `protobuf:"fixed64,6,opt,name=relative_gap,json=relativeGap,proto3" json:"relative_gap,omitempty"`
+	// disable_heuristics turns the primal heuristics off
+	DisableHeuristics bool `protobuf:"varint,7,opt,name=disable_heuristics,json=disableHeuristics,proto3" json:"disable_heuristics,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Options) Reset() {
+	*x = Options{}
+	mi := &file_solverpb_solver_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Options) ProtoMessage() {}
+
+func (x *Options) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Options.ProtoReflect.Descriptor instead.
+func (*Options) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Options) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *Options) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
+func (x *Options) GetTimeLimitSeconds() float64 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *Options) GetNodeLimit() int32 {
+	if x != nil {
+		return x.NodeLimit
+	}
+	return 0
+}
+
+func (x *Options) GetSolutionLimit() int32 {
+	if x != nil {
+		return x.SolutionLimit
+	}
+	return 0
+}
+
+func (x *Options) GetRelativeGap() float64 {
+	if x != nil {
+		return x.RelativeGap
+	}
+	return 0
+}
+
+func (x *Options) GetDisableHeuristics() bool {
+	if x != nil {
+		return x.DisableHeuristics
+	}
+	return false
+}
+
+type SolveResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status Status                 `protobuf:"varint,2,opt,name=status,proto3,enum=pnle.solver.v1.Status" json:"status,omitempty"`
+	// objective is only set when a solution was found
+	Objective *float64 `protobuf:"fixed64,3,opt,name=objective,proto3,oneof" json:"objective,omitempty"`
+	// values are keyed by variable name
+	Values map[string]float64 `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// duals are the shadow prices of the constraints in the linear relaxation,
+	// keyed by constraint name
+	Duals         map[string]float64 `protobuf:"bytes,5,rep,name=duals,proto3" json:"duals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Statistics    *Statistics        `protobuf:"bytes,6,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveResult) Reset() {
+	*x = SolveResult{}
+	mi := &file_solverpb_solver_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveResult) ProtoMessage() {}
+
+func (x *SolveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveResult.ProtoReflect.Descriptor instead.
+func (*SolveResult) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SolveResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SolveResult) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *SolveResult) GetObjective() float64 {
+	if x != nil && x.Objective != nil {
+		return *x.Objective
+	}
+	return 0
+}
+
+func (x *SolveResult) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *SolveResult) GetDuals() map[string]float64 {
+	if x != nil {
+		return x.Duals
+	}
+	return nil
+}
+
+func (x *SolveResult) GetStatistics() *Statistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+type Statistics struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Nodes            int32                  `protobuf:"varint,1,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	DualBound        float64                `protobuf:"fixed64,2,opt,name=dual_bound,json=dualBound,proto3" json:"dual_bound,omitempty"`
+	AbsoluteGap      float64                `protobuf:"fixed64,3,opt,name=absolute_gap,json=absoluteGap,proto3" json:"absolute_gap,omitempty"`
+	RelativeGap      float64                `protobuf:"fixed64,4,opt,name=relative_gap,json=relativeGap,proto3" json:"relative_gap,omitempty"`
+	SolveTimeSeconds float64                `protobuf:"fixed64,5,opt,name=solve_time_seconds,json=solveTimeSeconds,proto3" json:"solve_time_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Statistics) Reset() {
+	*x = Statistics{}
+	mi := &file_solverpb_solver_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Statistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Statistics) ProtoMessage() {}
+
+func (x *Statistics) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Statistics.ProtoReflect.Descriptor instead.
+func (*Statistics) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Statistics) GetNodes() int32 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
+}
+
+func (x *Statistics) GetDualBound() float64 {
+	if x != nil {
+		return x.DualBound
+	}
+	return 0
+}
+
+func (x *Statistics) GetAbsoluteGap() float64 {
+	if x != nil {
+		return x.AbsoluteGap
+	}
+	return 0
+}
+
+func (x *Statistics) GetRelativeGap() float64 {
+	if x != nil {
+		return x.RelativeGap
+	}
+	return 0
+}
+
+func (x *Statistics) GetSolveTimeSeconds() float64 {
+	if x != nil {
+		return x.SolveTimeSeconds
+	}
+	return 0
+}
+
+// SolveEvent is an event of SolveStream, the result is the last one
+type SolveEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*SolveEvent_Pivot
+	//	*SolveEvent_Node
+	//	*SolveEvent_Incumbent
+	//	*SolveEvent_Gap
+	//	*SolveEvent_Result
+	Event         isSolveEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveEvent) Reset() {
+	*x = SolveEvent{}
+	mi := &file_solverpb_solver_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveEvent) ProtoMessage() {}
+
+func (x *SolveEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveEvent.ProtoReflect.Descriptor instead.
+func (*SolveEvent) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SolveEvent) GetEvent() isSolveEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SolveEvent) GetPivot() *PivotEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SolveEvent_Pivot); ok {
+			return x.Pivot
+		}
+	}
+	return nil
+}
+
+func (x *SolveEvent) GetNode() *NodeEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SolveEvent_Node); ok {
+			return x.Node
+		}
+	}
+	return nil
+}
+
+func (x *SolveEvent) GetIncumbent() *IncumbentEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SolveEvent_Incumbent); ok {
+			return x.Incumbent
+		}
+	}
+	return nil
+}
+
+func (x *SolveEvent) GetGap() *GapEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SolveEvent_Gap); ok {
+			return x.Gap
+		}
+	}
+	return nil
+}
+
+func (x *SolveEvent) GetResult() *SolveResult {
+	if x != nil {
+		if x, ok := x.Event.(*SolveEvent_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+type isSolveEvent_Event interface {
+	isSolveEvent_Event()
+}
+
+type SolveEvent_Pivot struct {
+	Pivot *PivotEvent `protobuf:"bytes,1,opt,name=pivot,proto3,oneof"`
+}
+
+type SolveEvent_Node struct {
+	Node *NodeEvent `protobuf:"bytes,2,opt,name=node,proto3,oneof"`
+}
+
+type SolveEvent_Incumbent struct {
+	Incumbent *IncumbentEvent `protobuf:"bytes,3,opt,name=incumbent,proto3,oneof"`
+}
+
+type SolveEvent_Gap struct {
+	Gap *GapEvent `protobuf:"bytes,4,opt,name=gap,proto3,oneof"`
+}
+
+type SolveEvent_Result struct {
+	Result *SolveResult `protobuf:"bytes,5,opt,name=result,proto3,oneof"`
+}
+
+func (*SolveEvent_Pivot) isSolveEvent_Event() {}
+
+func (*SolveEvent_Node) isSolveEvent_Event() {}
+
+func (*SolveEvent_Incumbent) isSolveEvent_Event() {}
+
+func (*SolveEvent_Gap) isSolveEvent_Event() {}
+
+func (*SolveEvent_Result) isSolveEvent_Event() {}
+
+// PivotEvent is a simplex pivot, they are dropped when the client reads the
+// stream slower than the solver pivots
+type PivotEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phase         int32                  `protobuf:"varint,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Iteration     int32                  `protobuf:"varint,2,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	Explanation   string                 `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PivotEvent) Reset() {
+	*x = PivotEvent{}
+	mi := &file_solverpb_solver_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PivotEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PivotEvent) ProtoMessage() {}
+
+func (x *PivotEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PivotEvent.ProtoReflect.Descriptor instead.
+func (*PivotEvent) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PivotEvent) GetPhase() int32 {
+	if x != nil {
+		return x.Phase
+	}
+	return 0
+}
+
+func (x *PivotEvent) GetIteration() int32 {
+	if x != nil {
+		return x.Iteration
+	}
+	return 0
+}
+
+func (x *PivotEvent) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type NodeEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId int32                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Depth    int32                  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// status is created, started, branched, pruned_bound, pruned_infeasible,
+	// pruned_integral or pruned_unbounded
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// value is the relaxation value, it is not set for created, started and
+	// infeasible nodes
+	Value         *float64 `protobuf:"fixed64,5,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeEvent) Reset() {
+	*x = NodeEvent{}
+	mi := &file_solverpb_solver_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeEvent) ProtoMessage() {}
+
+func (x *NodeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeEvent.ProtoReflect.Descriptor instead.
+func (*NodeEvent) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NodeEvent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NodeEvent) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *NodeEvent) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *NodeEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetValue() float64 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+type IncumbentEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Value float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// values are keyed by variable name
+	Values        map[string]float64 `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Source        string             `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	NodeId        int32              `protobuf:"varint,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncumbentEvent) Reset() {
+	*x = IncumbentEvent{}
+	mi := &file_solverpb_solver_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncumbentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncumbentEvent) ProtoMessage() {}
+
+func (x *IncumbentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncumbentEvent.ProtoReflect.Descriptor instead.
+func (*IncumbentEvent) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IncumbentEvent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *IncumbentEvent) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *IncumbentEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *IncumbentEvent) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+type GapEvent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Incumbent   float64                `protobuf:"fixed64,1,opt,name=incumbent,proto3" json:"incumbent,omitempty"`
+	DualBound   float64                `protobuf:"fixed64,2,opt,name=dual_bound,json=dualBound,proto3" json:"dual_bound,omitempty"`
+	AbsoluteGap float64                `protobuf:"fixed64,3,opt,name=absolute_gap,json=absoluteGap,proto3" json:"absolute_gap,omitempty"`
+	RelativeGap float64                `protobuf:"fixed64,4,opt,name=relative_gap,json=relativeGap,proto3" json:"relative_gap,omitempty"`
+	Nodes       int32                  `protobuf:"varint,5,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	// elapsed_seconds is the time since the start of the search
+	ElapsedSeconds float64 `protobuf:"fixed64,6,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GapEvent) Reset() {
+	*x = GapEvent{}
+	mi := &file_solverpb_solver_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GapEvent) ProtoMessage() {}
+
+func (x *GapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_solverpb_solver_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GapEvent.ProtoReflect.Descriptor instead.
+func (*GapEvent) Descriptor() ([]byte, []int) {
+	return file_solverpb_solver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GapEvent) GetIncumbent() float64 {
+	if x != nil {
+		return x.Incumbent
+	}
+	return 0
+}
+
+func (x *GapEvent) GetDualBound() float64 {
+	if x != nil {
+		return x.DualBound
+	}
+	return 0
+}
+
+func (x *GapEvent) GetAbsoluteGap() float64 {
+	if x != nil {
+		return x.AbsoluteGap
+	}
+	return 0
+}
+
+func (x *GapEvent) GetRelativeGap() float64 {
+	if x != nil {
+		return x.RelativeGap
+	}
+	return 0
+}
+
+func (x *GapEvent) GetNodes() int32 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
+}
+
+func (x *GapEvent) GetElapsedSeconds() float64 {
+	if x != nil {
+		return x.ElapsedSeconds
+	}
+	return 0
+}
+
+var File_solverpb_solver_proto protoreflect.FileDescriptor
+
+var file_solverpb_solver_proto_rawDesc = string([]byte{
+	0x0a, 0x15, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2f, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0xb3, 0x01, 0x0a, 0x0c, 0x53, 0x6f, 0x6c, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x31, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x78, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x78, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0xcc, 0x01,
+	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
+	0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3c,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x9a, 0x01, 0x0a,
+	0x08, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x6e,
+	0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x19, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x75, 0x70,
+	0x70, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x05, 0x75, 0x70, 0x70,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x22, 0xca, 0x01, 0x0a, 0x09, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x65, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x73,
+	0x65, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x6e, 0x6c,
+	0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf9, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0c, 0x63, 0x6f, 0x65,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x65, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63,
+	0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x6e, 0x6c, 0x65,
+	0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x68, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x72, 0x68,
+	0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x8f, 0x02, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x2c,
+	0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67,
+	0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x47, 0x61, 0x70, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x68, 0x65, 0x75, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x65, 0x75, 0x72, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x22, 0xb2, 0x03, 0x0a, 0x0b, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x6e,
+	0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x05,
+	0x64, 0x75, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6e,
+	0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x44, 0x75, 0x61, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x75, 0x61, 0x6c, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x44, 0x75, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x64, 0x75, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x64, 0x75, 0x61, 0x6c, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x47, 0x61, 0x70,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x47, 0x61, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x10, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x9f, 0x02, 0x0a, 0x0a, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x05, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x70,
+	0x69, 0x76, 0x6f, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x75, 0x6d, 0x62, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x75, 0x6d, 0x62,
+	0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x75,
+	0x6d, 0x62, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x67, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x03,
+	0x67, 0x61, 0x70, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x22, 0x62, 0x0a, 0x0a, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x19, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x75, 0x6d, 0x62,
+	0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x63, 0x75, 0x6d, 0x62, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcc,
+	0x01, 0x0a, 0x08, 0x47, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6e, 0x63, 0x75, 0x6d, 0x62, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x69, 0x6e, 0x63, 0x75, 0x6d, 0x62, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x75, 0x61,
+	0x6c, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64,
+	0x75, 0x61, 0x6c, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x62, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x47, 0x61, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65,
+	0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x2a, 0x61, 0x0a,
+	0x0c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a,
+	0x15, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x41, 0x52, 0x49,
+	0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e,
+	0x55, 0x4f, 0x55, 0x53, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42,
+	0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x02,
+	0x2a, 0x3c, 0x0a, 0x05, 0x53, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x4e,
+	0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x53, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x2a, 0x8f,
+	0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c,
+	0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x51,
+	0x55, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41,
+	0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x03,
+	0x2a, 0xd5, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50,
+	0x54, 0x49, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x49, 0x4e, 0x46, 0x45, 0x41, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x47,
+	0x41, 0x50, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10,
+	0x05, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x08, 0x32, 0x97, 0x01, 0x0a, 0x06, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x05, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x70,
+	0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6e, 0x6c,
+	0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x49, 0x0a, 0x0b, 0x53, 0x6f, 0x6c, 0x76, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6e, 0x6c, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x42, 0x17, 0x5a, 0x15, 0x70, 0x6e, 0x6c, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x61,
+	0x70, 0x69, 0x2f, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+})
+
+var (
+	file_solverpb_solver_proto_rawDescOnce sync.Once
+	file_solverpb_solver_proto_rawDescData []byte
+)
+
+func file_solverpb_solver_proto_rawDescGZIP() []byte {
+	file_solverpb_solver_proto_rawDescOnce.Do(func() {
+		file_solverpb_solver_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_solverpb_solver_proto_rawDesc), len(file_solverpb_solver_proto_rawDesc)))
+	})
+	return file_solverpb_solver_proto_rawDescData
+}
+
+var file_solverpb_solver_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_solverpb_solver_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_solverpb_solver_proto_goTypes = []any{
+	(VariableType)(0),      // 0: pnle.solver.v1.VariableType
+	(Sense)(0),             // 1: pnle.solver.v1.Sense
+	(ConstraintType)(0),    // 2: pnle.solver.v1.ConstraintType
+	(Status)(0),            // 3: pnle.solver.v1.Status
+	(*SolveRequest)(nil),   // 4: pnle.solver.v1.SolveRequest
+	(*Problem)(nil),        // 5: pnle.solver.v1.Problem
+	(*Variable)(nil),       // 6: pnle.solver.v1.Variable
+	(*Objective)(nil),      // 7: pnle.solver.v1.Objective
+	(*Constraint)(nil),     // 8: pnle.solver.v1.Constraint
+	(*Options)(nil),        // 9: pnle.solver.v1.Options
+	(*SolveResult)(nil),    // 10: pnle.solver.v1.SolveResult
+	(*Statistics)(nil),     // 11: pnle.solver.v1.Statistics
+	(*SolveEvent)(nil),     // 12: pnle.solver.v1.SolveEvent
+	(*PivotEvent)(nil),     // 13: pnle.solver.v1.PivotEvent
+	(*NodeEvent)(nil),      // 14: pnle.solver.v1.NodeEvent
+	(*IncumbentEvent)(nil), // 15: pnle.solver.v1.IncumbentEvent
+	(*GapEvent)(nil),       // 16: pnle.solver.v1.GapEvent
+	nil,                    // 17: pnle.solver.v1.Objective.CoefficientsEntry
+	nil,                    // 18: pnle.solver.v1.Constraint.CoefficientsEntry
+	nil,                    // 19: pnle.solver.v1.SolveResult.ValuesEntry
+	nil,                    // 20: pnle.solver.v1.SolveResult.DualsEntry
+	nil,                    // 21: pnle.solver.v1.IncumbentEvent.ValuesEntry
+}
+var file_solverpb_solver_proto_depIdxs = []int32{
+	5,  // 0: pnle.solver.v1.SolveRequest.model:type_name -> pnle.solver.v1.Problem
+	9,  // 1: pnle.solver.v1.SolveRequest.options:type_name -> pnle.solver.v1.Options
+	6,  // 2: pnle.solver.v1.Problem.variables:type_name -> pnle.solver.v1.Variable
+	7,  // 3: pnle.solver.v1.Problem.objective:type_name -> pnle.solver.v1.Objective
+	8,  // 4: pnle.solver.v1.Problem.constraints:type_name -> pnle.solver.v1.Constraint
+	0,  // 5: pnle.solver.v1.Variable.type:type_name -> pnle.solver.v1.VariableType
+	1,  // 6: pnle.solver.v1.Objective.sense:type_name -> pnle.solver.v1.Sense
+	17, // 7: pnle.solver.v1.Objective.coefficients:type_name -> pnle.solver.v1.Objective.CoefficientsEntry
+	18, // 8: pnle.solver.v1.Constraint.coefficients:type_name -> pnle.solver.v1.Constraint.CoefficientsEntry
+	2,  // 9: pnle.solver.v1.Constraint.type:type_name -> pnle.solver.v1.ConstraintType
+	3,  // 10: pnle.solver.v1.SolveResult.status:type_name -> pnle.solver.v1.Status
+	19, // 11: pnle.solver.v1.SolveResult.values:type_name -> pnle.solver.v1.SolveResult.ValuesEntry
+	20, // 12: pnle.solver.v1.SolveResult.duals:type_name -> pnle.solver.v1.SolveResult.DualsEntry
+	11, // 13: pnle.solver.v1.SolveResult.statistics:type_name -> pnle.solver.v1.Statistics
+	13, // 14: pnle.solver.v1.SolveEvent.pivot:type_name -> pnle.solver.v1.PivotEvent
+	14, // 15: pnle.solver.v1.SolveEvent.node:type_name -> pnle.solver.v1.NodeEvent
+	15, // 16: pnle.solver.v1.SolveEvent.incumbent:type_name -> pnle.solver.v1.IncumbentEvent
+	16, // 17: pnle.solver.v1.SolveEvent.gap:type_name -> pnle.solver.v1.GapEvent
+	10, // 18: pnle.solver.v1.SolveEvent.result:type_name -> pnle.solver.v1.SolveResult
+	21, // 19: pnle.solver.v1.IncumbentEvent.values:type_name -> pnle.solver.v1.IncumbentEvent.ValuesEntry
+	4,  // 20: pnle.solver.v1.Solver.Solve:input_type -> pnle.solver.v1.SolveRequest
+	4,  // 21: pnle.solver.v1.Solver.SolveStream:input_type -> pnle.solver.v1.SolveRequest
+	10, // 22: pnle.solver.v1.Solver.Solve:output_type -> pnle.solver.v1.SolveResult
+	12, // 23: pnle.solver.v1.Solver.SolveStream:output_type -> pnle.solver.v1.SolveEvent
+	22, // [22:24] is the sub-list for method output_type
+	20, // [20:22] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_solverpb_solver_proto_init() }
+func file_solverpb_solver_proto_init() {
+	if File_solverpb_solver_proto != nil {
+		return
+	}
+	file_solverpb_solver_proto_msgTypes[0].OneofWrappers = []any{
+		(*SolveRequest_Model)(nil),
+		(*SolveRequest_Text)(nil),
+	}
+	file_solverpb_solver_proto_msgTypes[2].OneofWrappers = []any{}
+	file_solverpb_solver_proto_msgTypes[6].OneofWrappers = []any{}
+	file_solverpb_solver_proto_msgTypes[8].OneofWrappers = []any{
+		(*SolveEvent_Pivot)(nil),
+		(*SolveEvent_Node)(nil),
+		(*SolveEvent_Incumbent)(nil),
+		(*SolveEvent_Gap)(nil),
+		(*SolveEvent_Result)(nil),
+	}
+	file_solverpb_solver_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_solverpb_solver_proto_rawDesc), len(file_solverpb_solver_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_solverpb_solver_proto_goTypes,
+		DependencyIndexes: file_solverpb_solver_proto_depIdxs,
+		EnumInfos:         file_solverpb_solver_proto_enumTypes,
+		MessageInfos:      file_solverpb_solver_proto_msgTypes,
+	}.Build()
+	File_solverpb_solver_proto = out.File
+	file_solverpb_solver_proto_goTypes = nil
+	file_solverpb_solver_proto_depIdxs = nil
+}
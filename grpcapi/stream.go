@@ -0,0 +1,50 @@
+package grpcapi
+
+import (
+	"pnle/grpcapi/solverpb"
+	"pnle/internal/transport"
+)
+
+// newSolveEvent maps an event of the solver to an event of SolveStream, the
+// values of an incumbent are keyed by the variable names
+func newSolveEvent(event transport.Event, names []string) *solverpb.SolveEvent {
+	switch data := event.Data.(type) {
+	case transport.Pivot:
+		return &solverpb.SolveEvent{Event: &solverpb.SolveEvent_Pivot{Pivot: &solverpb.PivotEvent{
+			Phase:       int32(data.Phase),
+			Iteration:   data.Iteration,
+			Explanation: data.Explanation,
+		}}}
+	case transport.Node:
+		return &solverpb.SolveEvent{Event: &solverpb.SolveEvent_Node{Node: &solverpb.NodeEvent{
+			Id:       int32(data.ID),
+			ParentId: int32(data.ParentID),
+			Depth:    int32(data.Depth),
+			Status:   data.Status,
+			Value:    data.Value,
+		}}}
+	case transport.Incumbent:
+		incumbent := &solverpb.IncumbentEvent{
+			Value:  data.Value,
+			Values: make(map[string]float64, len(names)),
+			Source: data.Source,
+			NodeId: int32(data.NodeID),
+		}
+		for j, name := range names {
+			if j < len(data.Values) {
+				incumbent.Values[name] = data.Values[j]
+			}
+		}
+		return &solverpb.SolveEvent{Event: &solverpb.SolveEvent_Incumbent{Incumbent: incumbent}}
+	case transport.Gap:
+		return &solverpb.SolveEvent{Event: &solverpb.SolveEvent_Gap{Gap: &solverpb.GapEvent{
+			Incumbent:      data.Incumbent,
+			DualBound:      data.DualBound,
+			AbsoluteGap:    data.AbsoluteGap,
+			RelativeGap:    data.RelativeGap,
+			Nodes:          int32(data.Nodes),
+			ElapsedSeconds: data.ElapsedSeconds,
+		}}}
+	}
+	return nil
+}
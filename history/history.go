@@ -0,0 +1,181 @@
+// Package history keeps the solved problems and their results in a local bbolt
+// database
+package history
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var ErrNotFound = errors.New("history entry not found")
+
+var (
+	// summaries holds the Summary of each entry, listed without reading the responses
+	summaries = []byte("summaries")
+	// responses holds the Response of each entry under the same key
+	responses = []byte("responses")
+)
+
+// Entry is a solved problem, Response is the body answered by the solve so the
+// result can be shown again without solving the problem
+type Entry struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Problem is the problem in the text format
+	Problem   string          `json:"problem"`
+	Status    string          `json:"status"`
+	Objective *float64        `json:"objective,omitempty"`
+	Response  json.RawMessage `json:"response"`
+}
+
+// Summary is an entry without its response, as listed in the history
+type Summary struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	Problem   string    `json:"problem"`
+	Status    string    `json:"status"`
+	Objective *float64  `json:"objective,omitempty"`
+}
+
+// Store is a history saved in a bbolt database. The key of an entry is its
+// creation time followed by random bytes, so the keys are in creation order,
+// and its ID is the key in hexadecimal
+type Store struct {
+	db   *bolt.DB
+	size int
+}
+
+// Open opens the history saved at path, creating it when it is missing. Only
+// the size latest entries are kept, or all of them when size is 0
+func Open(path string, size int) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{db: db, size: size}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{summaries, responses} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		// the size may be lower than when the entries were added
+		return s.trim(tx)
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Add saves a new entry, its ID and creation time are set by the store
+func (s *Store) Add(entry Entry) (Entry, error) {
+	entry.CreatedAt = time.Now().UTC()
+	key := make([]byte, 12)
+	binary.BigEndian.PutUint64(key, uint64(entry.CreatedAt.UnixNano()))
+	if _, err := rand.Read(key[8:]); err != nil {
+		return Entry{}, err
+	}
+	entry.ID = hex.EncodeToString(key)
+	summary, err := json.Marshal(Summary{entry.ID, entry.CreatedAt, entry.Problem, entry.Status, entry.Objective})
+	if err != nil {
+		return Entry{}, err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(summaries).Put(key, summary); err != nil {
+			return err
+		}
+		if err := tx.Bucket(responses).Put(key, entry.Response); err != nil {
+			return err
+		}
+		return s.trim(tx)
+	})
+	return entry, err
+}
+
+// List returns the summaries of the entries, latest first
+func (s *Store) List() ([]Summary, error) {
+	list := []Summary{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(summaries).Cursor()
+		for key, value := cursor.Last(); key != nil; key, value = cursor.Prev() {
+			var summary Summary
+			if err := json.Unmarshal(value, &summary); err != nil {
+				return err
+			}
+			list = append(list, summary)
+		}
+		return nil
+	})
+	return list, err
+}
+
+func (s *Store) Get(id string) (Entry, error) {
+	key, err := hex.DecodeString(id)
+	if err != nil {
+		return Entry{}, ErrNotFound
+	}
+	var entry Entry
+	err = s.db.View(func(tx *bolt.Tx) error {
+		summary := tx.Bucket(summaries).Get(key)
+		if summary == nil {
+			return ErrNotFound
+		}
+		if err := json.Unmarshal(summary, &entry); err != nil {
+			return err
+		}
+		// the value is only valid during the transaction
+		entry.Response = append(json.RawMessage(nil), tx.Bucket(responses).Get(key)...)
+		return nil
+	})
+	return entry, err
+}
+
+func (s *Store) Delete(id string) error {
+	key, err := hex.DecodeString(id)
+	if err != nil {
+		return ErrNotFound
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(summaries).Get(key) == nil {
+			return ErrNotFound
+		}
+		if err := tx.Bucket(summaries).Delete(key); err != nil {
+			return err
+		}
+		return tx.Bucket(responses).Delete(key)
+	})
+}
+
+// trim deletes the oldest entries over the size of the store
+func (s *Store) trim(tx *bolt.Tx) error {
+	if s.size <= 0 {
+		return nil
+	}
+	// the keys are collected first, deleting under a cursor skips keys
+	var keys [][]byte
+	cursor := tx.Bucket(summaries).Cursor()
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		keys = append(keys, append([]byte(nil), key...))
+	}
+	for _, key := range keys[:max(0, len(keys)-s.size)] {
+		if err := tx.Bucket(summaries).Delete(key); err != nil {
+			return err
+		}
+		if err := tx.Bucket(responses).Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
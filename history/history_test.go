@@ -0,0 +1,81 @@
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.db")
+	store, err := Open(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	objective := 11.0
+	added, err := store.Add(Entry{Problem: "max 3 2", Status: "optimal", Objective: &objective, Response: json.RawMessage(`{"nodes":3}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, err := store.Get(added.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Problem != "max 3 2" || *entry.Objective != 11 || string(entry.Response) != `{"nodes":3}` {
+		t.Errorf("Get() = %+v", entry)
+	}
+	if err := store.Delete(added.ID); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{added.ID, "not hexadecimal"} {
+		if _, err := store.Get(id); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Get(%q) error = %v, want ErrNotFound", id, err)
+		}
+		if err := store.Delete(id); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Delete(%q) error = %v, want ErrNotFound", id, err)
+		}
+	}
+	store.Close()
+}
+
+func TestSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.db")
+	store, err := Open(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, problem := range []string{"a", "b", "c", "d"} {
+		if _, err := store.Add(Entry{Problem: problem}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	store.Close()
+
+	// reopening with a lower size drops the oldest entries
+	if store, err = Open(path, 2); err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	assertProblems(t, store, "d", "c")
+	if _, err := store.Add(Entry{Problem: "e"}); err != nil {
+		t.Fatal(err)
+	}
+	assertProblems(t, store, "e", "d")
+}
+
+func assertProblems(t *testing.T, store *Store, want ...string) {
+	t.Helper()
+	list, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var problems []string
+	for _, summary := range list {
+		problems = append(problems, summary.Problem)
+	}
+	if !slices.Equal(problems, want) {
+		t.Errorf("List() = %v, want %v", problems, want)
+	}
+}
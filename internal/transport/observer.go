@@ -0,0 +1,132 @@
+// Package transport holds what the HTTP and gRPC servers share: the observer
+// buffering the solver events streamed to the clients, the IDs of the requests
+// and the limit of the solves running at the same time
+package transport
+
+import (
+	"context"
+	"pnle/lp"
+)
+
+// Buffer is the number of events waiting to be sent to the client, pivots are
+// dropped when it is full so a slow client cannot stall the solver
+const Buffer = 256
+
+// Event is an event of a streamed solve, Data is the Pivot, Node, Incumbent or
+// Gap named by Name
+type Event struct {
+	Name string
+	Data any
+}
+
+type Pivot struct {
+	Phase       int8   `json:"phase"`
+	Iteration   int32  `json:"iteration"`
+	Explanation string `json:"explanation"`
+}
+
+type Node struct {
+	ID       int    `json:"id"`
+	ParentID int    `json:"parentId"`
+	Depth    int    `json:"depth"`
+	Status   string `json:"status"`
+	// Value is the relaxation value, it is not set for created, started and
+	// infeasible nodes
+	Value *float64 `json:"value,omitempty"`
+}
+
+type Incumbent struct {
+	Value  float64   `json:"value"`
+	Values []float64 `json:"values"`
+	Source string    `json:"source"`
+	NodeID int       `json:"nodeId"`
+}
+
+type Gap struct {
+	Incumbent   float64 `json:"incumbent"`
+	DualBound   float64 `json:"dualBound"`
+	AbsoluteGap float64 `json:"absoluteGap"`
+	RelativeGap float64 `json:"relativeGap"`
+	Nodes       int     `json:"nodes"`
+	// ElapsedSeconds is the time since the start of the search
+	ElapsedSeconds float64 `json:"elapsedSeconds"`
+}
+
+// Observer turns the solver events into Events, read from Events until Close
+// is called once the solve is over
+type Observer struct {
+	lp.BaseObserver
+	ctx    context.Context
+	events chan Event
+}
+
+// NewObserver returns an observer that stops waiting for room in its buffer
+// once ctx is done
+func NewObserver(ctx context.Context) *Observer {
+	return &Observer{ctx: ctx, events: make(chan Event, Buffer)}
+}
+
+func (o *Observer) Events() <-chan Event {
+	return o.events
+}
+
+func (o *Observer) Close() {
+	close(o.events)
+}
+
+// Drain discards the events left until Close, to let the solver return when
+// the client is gone before the end
+func (o *Observer) Drain() {
+	for range o.events {
+	}
+}
+
+// send waits for room in the buffer unless the client is gone
+func (o *Observer) send(name string, data any) {
+	select {
+	case o.events <- Event{Name: name, Data: data}:
+	case <-o.ctx.Done():
+	}
+}
+
+func (o *Observer) PivotPerformed(event lp.PivotEvent) {
+	select {
+	case o.events <- Event{Name: "pivot", Data: Pivot{event.Phase, event.Iteration, event.Explanation}}:
+	default:
+	}
+}
+
+func (o *Observer) NodeCreated(event lp.NodeEvent) {
+	o.send("node", Node{ID: event.ID, ParentID: event.ParentID, Depth: event.Depth, Status: "created"})
+}
+
+func (o *Observer) NodeStarted(event lp.NodeEvent) {
+	o.send("node", Node{ID: event.ID, ParentID: event.ParentID, Depth: event.Depth, Status: "started"})
+}
+
+func (o *Observer) NodeBranched(event lp.NodeBranchedEvent) {
+	o.send("node", Node{ID: event.ID, Status: string(lp.NodeBranched), Value: &event.Value})
+}
+
+func (o *Observer) NodePruned(event lp.NodePrunedEvent) {
+	node := Node{ID: event.ID, Status: "pruned_" + string(event.Reason)}
+	if event.Reason != lp.PrunedInfeasible && event.Reason != lp.PrunedUnbounded {
+		node.Value = &event.Value
+	}
+	o.send("node", node)
+}
+
+func (o *Observer) IncumbentFound(event lp.IncumbentEvent) {
+	o.send("incumbent", Incumbent{event.Value, event.Values, event.Source, event.NodeID})
+}
+
+func (o *Observer) GapChanged(event lp.GapEvent) {
+	o.send("gap", Gap{
+		Incumbent:      event.Incumbent,
+		DualBound:      event.DualBound,
+		AbsoluteGap:    event.AbsoluteGap,
+		RelativeGap:    event.RelativeGap,
+		Nodes:          event.Nodes,
+		ElapsedSeconds: event.Elapsed.Seconds(),
+	})
+}
@@ -0,0 +1,42 @@
+package transport
+
+// Semaphore bounds the number of problems solved at the same time by the HTTP
+// and gRPC servers together, a nil Semaphore has no bound
+type Semaphore struct {
+	slots chan struct{}
+}
+
+// NewSemaphore returns a semaphore of size slots, or nil when size is not
+// positive
+func NewSemaphore(size int) *Semaphore {
+	if size <= 0 {
+		return nil
+	}
+	return &Semaphore{slots: make(chan struct{}, size)}
+}
+
+// TryAcquire takes up to n free slots without waiting and returns how many it
+// took, they are given back with Release
+func (s *Semaphore) TryAcquire(n int) int {
+	if s == nil {
+		return n
+	}
+	for i := 0; i < n; i++ {
+		select {
+		case s.slots <- struct{}{}:
+		default:
+			return i
+		}
+	}
+	return n
+}
+
+// Release gives back n slots taken by TryAcquire
+func (s *Semaphore) Release(n int) {
+	if s == nil {
+		return
+	}
+	for i := 0; i < n; i++ {
+		<-s.slots
+	}
+}
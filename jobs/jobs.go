@@ -0,0 +1,239 @@
+// Package jobs runs solves in the background on a bounded pool of workers
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"pnle/api"
+	"sync"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusQueued   Status = "queued"
+	StatusRunning  Status = "running"
+	StatusDone     Status = "done"
+	StatusCanceled Status = "canceled"
+	StatusFailed   Status = "failed"
+)
+
+var (
+	ErrQueueFull = errors.New("the job queue is full")
+	ErrNotFound  = errors.New("job not found")
+	ErrFinished  = errors.New("the job is already finished")
+)
+
+// SolveFunc solves the problem of a job, it must stop soon after ctx is done
+type SolveFunc func(ctx context.Context, problem *api.Problem) (*api.Result, error)
+
+// Job is a snapshot of a solve job
+type Job struct {
+	ID         string      `json:"id"`
+	Status     Status      `json:"status"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	StartedAt  *time.Time  `json:"startedAt,omitempty"`
+	FinishedAt *time.Time  `json:"finishedAt,omitempty"`
+	Result     *api.Result `json:"result,omitempty"`
+	Error      string      `json:"error,omitempty"`
+	problem    *api.Problem
+	cancel     context.CancelFunc
+}
+
+func (job *Job) finished() bool {
+	return job.Status == StatusDone || job.Status == StatusCanceled || job.Status == StatusFailed
+}
+
+// Manager queues the jobs, runs them on its workers and forgets the finished
+// jobs after their time to live
+type Manager struct {
+	solve  SolveFunc
+	ttl    time.Duration
+	ctx    context.Context
+	cancel context.CancelFunc
+	queue  chan *Job
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	jobs   map[string]*Job
+}
+
+// DefaultTTL is the time to live of the finished jobs when none is given
+const DefaultTTL = 15 * time.Minute
+
+// NewManager starts workers goroutines taking jobs from a queue of queueSize
+// jobs, finished jobs are kept for ttl
+func NewManager(solve SolveFunc, workers int, queueSize int, ttl time.Duration) *Manager {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{
+		solve:  solve,
+		ttl:    ttl,
+		ctx:    ctx,
+		cancel: cancel,
+		queue:  make(chan *Job, queueSize),
+		jobs:   make(map[string]*Job),
+	}
+	for i := 0; i < workers; i++ {
+		m.wg.Add(1)
+		go m.work()
+	}
+	m.wg.Add(1)
+	go m.expire()
+	return m
+}
+
+// Submit queues a solve of problem, it returns ErrQueueFull when every worker
+// is busy and the queue holds queueSize jobs
+func (m *Manager) Submit(problem *api.Problem) (Job, error) {
+	id, err := newID()
+	if err != nil {
+		return Job{}, err
+	}
+	job := &Job{ID: id, Status: StatusQueued, CreatedAt: time.Now(), problem: problem}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	select {
+	case m.queue <- job:
+	default:
+		return Job{}, ErrQueueFull
+	}
+	m.jobs[id] = job
+	return *job, nil
+}
+
+func (m *Manager) Get(id string) (Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok {
+		return Job{}, ErrNotFound
+	}
+	return *job, nil
+}
+
+// Counts returns the number of queued and running jobs
+func (m *Manager) Counts() (queued int, running int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, job := range m.jobs {
+		switch job.Status {
+		case StatusQueued:
+			queued++
+		case StatusRunning:
+			running++
+		}
+	}
+	return queued, running
+}
+
+// Cancel stops a running job or drops a queued one
+func (m *Manager) Cancel(id string) (Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok {
+		return Job{}, ErrNotFound
+	}
+	if job.finished() {
+		return *job, ErrFinished
+	}
+	if job.Status == StatusQueued {
+		// the worker skips it when it reaches it in the queue
+		m.finish(job, StatusCanceled)
+	} else {
+		job.cancel()
+	}
+	return *job, nil
+}
+
+// Close cancels the running jobs and waits for the workers to return
+func (m *Manager) Close() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+func (m *Manager) work() {
+	defer m.wg.Done()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case job := <-m.queue:
+			m.run(job)
+		}
+	}
+}
+
+func (m *Manager) run(job *Job) {
+	m.mu.Lock()
+	if job.Status != StatusQueued {
+		m.mu.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(m.ctx)
+	defer cancel()
+	job.cancel = cancel
+	job.Status = StatusRunning
+	now := time.Now()
+	job.StartedAt = &now
+	problem := job.problem
+	m.mu.Unlock()
+
+	result, err := m.solve(ctx, problem)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job.Result = result
+	switch {
+	case err == nil:
+		m.finish(job, StatusDone)
+	case ctx.Err() != nil:
+		m.finish(job, StatusCanceled)
+	default:
+		job.Error = err.Error()
+		m.finish(job, StatusFailed)
+	}
+}
+
+// finish must be called with the lock held
+func (m *Manager) finish(job *Job, status Status) {
+	job.Status = status
+	now := time.Now()
+	job.FinishedAt = &now
+	// the problem is no longer needed once the job is finished
+	job.problem = nil
+}
+
+// expire removes the finished jobs older than the time to live
+func (m *Manager) expire() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(m.ttl / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.mu.Lock()
+			for id, job := range m.jobs {
+				if job.finished() && now.Sub(*job.FinishedAt) > m.ttl {
+					delete(m.jobs, id)
+				}
+			}
+			m.mu.Unlock()
+		}
+	}
+}
+
+func newID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,145 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"pnle/api"
+	"testing"
+	"time"
+)
+
+// waitFor polls the job until it has status
+func waitFor(t *testing.T, m *Manager, id string, status Status) Job {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job, err := m.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.Status == status {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job %s is %s, want %s", id, job.Status, status)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDone(t *testing.T) {
+	objective := 11.0
+	m := NewManager(func(ctx context.Context, problem *api.Problem) (*api.Result, error) {
+		return &api.Result{Name: problem.Name, Objective: &objective}, nil
+	}, 2, 10, 0)
+	defer m.Close()
+	submitted, err := m.Submit(&api.Problem{Name: "sample"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submitted.Status != StatusQueued {
+		t.Errorf("submitted job is %s, want queued", submitted.Status)
+	}
+	job := waitFor(t, m, submitted.ID, StatusDone)
+	if job.Result == nil || job.Result.Name != "sample" || *job.Result.Objective != 11 {
+		t.Errorf("result = %+v", job.Result)
+	}
+	if job.StartedAt == nil || job.FinishedAt == nil {
+		t.Error("the start and finish times are not set")
+	}
+	if _, err := m.Cancel(job.ID); !errors.Is(err, ErrFinished) {
+		t.Errorf("Cancel() error = %v, want ErrFinished", err)
+	}
+}
+
+func TestFailed(t *testing.T) {
+	m := NewManager(func(ctx context.Context, problem *api.Problem) (*api.Result, error) {
+		return nil, errors.New("invalid problem")
+	}, 1, 10, 0)
+	defer m.Close()
+	submitted, err := m.Submit(&api.Problem{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job := waitFor(t, m, submitted.ID, StatusFailed); job.Error != "invalid problem" {
+		t.Errorf("error = %q, want invalid problem", job.Error)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	started := make(chan struct{}, 1)
+	m := NewManager(func(ctx context.Context, problem *api.Problem) (*api.Result, error) {
+		started <- struct{}{}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}, 1, 10, 0)
+	defer m.Close()
+	running, err := m.Submit(&api.Problem{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-started
+	queued, err := m.Submit(&api.Problem{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if queuedCount, runningCount := m.Counts(); queuedCount != 1 || runningCount != 1 {
+		t.Errorf("Counts() = %d, %d, want 1, 1", queuedCount, runningCount)
+	}
+
+	// the queued job is dropped at once, the running one once its solve returns
+	if job, err := m.Cancel(queued.ID); err != nil || job.Status != StatusCanceled {
+		t.Errorf("Cancel(queued) = %s, %v, want canceled", job.Status, err)
+	}
+	if _, err := m.Cancel(running.ID); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, m, running.ID, StatusCanceled)
+	if _, err := m.Cancel("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Cancel(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestQueueFull(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	m := NewManager(func(ctx context.Context, problem *api.Problem) (*api.Result, error) {
+		started <- struct{}{}
+		<-release
+		return &api.Result{}, nil
+	}, 1, 1, 0)
+	defer m.Close()
+	defer close(release)
+	if _, err := m.Submit(&api.Problem{}); err != nil {
+		t.Fatal(err)
+	}
+	<-started
+	if _, err := m.Submit(&api.Problem{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Submit(&api.Problem{}); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("Submit() error = %v, want ErrQueueFull", err)
+	}
+}
+
+func TestExpire(t *testing.T) {
+	m := NewManager(func(ctx context.Context, problem *api.Problem) (*api.Result, error) {
+		return &api.Result{}, nil
+	}, 1, 1, 20*time.Millisecond)
+	defer m.Close()
+	submitted, err := m.Submit(&api.Problem{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, m, submitted.ID, StatusDone)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := m.Get(submitted.ID); errors.Is(err, ErrNotFound) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the finished job is still kept after its time to live")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
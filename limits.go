@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"pnle/internal/transport"
+	"pnle/metrics"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+var rejectedRequests = metrics.Default.NewCounter("pnle_rejected_requests_total",
+	"Requests refused by the limits of the server, by reason.", "reason")
+
+// limitBody answers 413 to the requests announcing a body larger than limit and
+// makes the reads of the other bodies fail after limit bytes
+func limitBody(limit int64) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.Request.ContentLength > limit {
+			rejectBody(ctx, limit)
+			return
+		}
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, limit)
+		ctx.Next()
+	}
+}
+
+// bodyTooLarge answers 413 when err comes from a body larger than its limit
+func bodyTooLarge(ctx *gin.Context, err error) bool {
+	var maxBytesError *http.MaxBytesError
+	if !errors.As(err, &maxBytesError) {
+		return false
+	}
+	rejectBody(ctx, maxBytesError.Limit)
+	return true
+}
+
+func rejectBody(ctx *gin.Context, limit int64) {
+	rejectedRequests.Inc("body_size")
+	ctx.AbortWithStatusJSON(413, gin.H{"error": fmt.Sprintf("the request body is larger than %d bytes", limit)})
+}
+
+// checkProblemSize answers 413 when a problem has more variables or constraints
+// than the server accepts
+func checkProblemSize(ctx *gin.Context, c *config, variables int, constraints int) bool {
+	var message string
+	switch {
+	case c.MaxVariables > 0 && variables > c.MaxVariables:
+		message = fmt.Sprintf("the problem has %d variables, the limit is %d", variables, c.MaxVariables)
+	case c.MaxConstraints > 0 && constraints > c.MaxConstraints:
+		message = fmt.Sprintf("the problem has %d constraints, the limit is %d", constraints, c.MaxConstraints)
+	default:
+		return true
+	}
+	rejectedRequests.Inc("problem_size")
+	ctx.AbortWithStatusJSON(413, gin.H{"error": message})
+	return false
+}
+
+// clientLimiter is the rate limiter of a client and when it was last used
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimit answers 429 to a client, identified by its IP address, sending
+// more than perSecond requests per second on average after a burst of burst
+// requests. A zero rate disables the limit
+func rateLimit(perSecond float64, burst int) gin.HandlerFunc {
+	if perSecond <= 0 {
+		return func(ctx *gin.Context) {}
+	}
+	var mu sync.Mutex
+	clients := make(map[string]*clientLimiter)
+	// a client is forgotten once its bucket is full again
+	idle := time.Duration(float64(burst)/perSecond*float64(time.Second)) + time.Minute
+	lastSweep := time.Now()
+	return func(ctx *gin.Context) {
+		now := time.Now()
+		mu.Lock()
+		if now.Sub(lastSweep) > idle {
+			for ip, client := range clients {
+				if now.Sub(client.lastSeen) > idle {
+					delete(clients, ip)
+				}
+			}
+			lastSweep = now
+		}
+		client, ok := clients[ctx.ClientIP()]
+		if !ok {
+			client = &clientLimiter{limiter: rate.NewLimiter(rate.Limit(perSecond), burst)}
+			clients[ctx.ClientIP()] = client
+		}
+		client.lastSeen = now
+		reservation := client.limiter.ReserveN(now, 1)
+		delay := reservation.DelayFrom(now)
+		if delay > 0 {
+			// the request is refused, it must not consume a token
+			reservation.CancelAt(now)
+		}
+		mu.Unlock()
+		if delay > 0 {
+			rejectedRequests.Inc("rate")
+			ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			ctx.AbortWithStatusJSON(429, gin.H{"error": "too many requests, retry later"})
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// limitSolves answers 429 when every slot of solves is taken by a problem being
+// solved, a nil semaphore disables the limit
+func limitSolves(solves *transport.Semaphore) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if solves.TryAcquire(1) == 0 {
+			rejectSolve(ctx)
+			return
+		}
+		defer solves.Release(1)
+		ctx.Next()
+	}
+}
+
+func rejectSolve(ctx *gin.Context) {
+	rejectedRequests.Inc("concurrent_solves")
+	ctx.Header("Retry-After", "1")
+	ctx.AbortWithStatusJSON(429, gin.H{"error": "too many solves in progress, retry later"})
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"pnle/internal/transport"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// limitedRouter answers 200 to GET / behind the handlers
+func limitedRouter(handlers ...gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/", append(handlers, func(ctx *gin.Context) { ctx.Status(200) })...)
+	return r
+}
+
+func get(r http.Handler, remoteAddr string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.RemoteAddr = remoteAddr
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestRateLimit(t *testing.T) {
+	r := limitedRouter(rateLimit(1, 2))
+	for i := 0; i < 2; i++ {
+		if response := get(r, "192.0.2.1:1234"); response.Code != 200 {
+			t.Fatalf("request %d of the burst answered %d", i+1, response.Code)
+		}
+	}
+	response := get(r, "192.0.2.1:1234")
+	if response.Code != 429 {
+		t.Fatalf("request after the burst answered %d, want 429", response.Code)
+	}
+	if retryAfter := response.Header().Get("Retry-After"); retryAfter != "1" {
+		t.Errorf("Retry-After = %q, want 1", retryAfter)
+	}
+	// the other clients have their own bucket
+	if response := get(r, "192.0.2.2:1234"); response.Code != 200 {
+		t.Errorf("another client answered %d, want 200", response.Code)
+	}
+	// a refused request does not use a token, so the bucket fills up on time
+	time.Sleep(time.Second)
+	if response := get(r, "192.0.2.1:1234"); response.Code != 200 {
+		t.Errorf("request after a second answered %d, want 200", response.Code)
+	}
+}
+
+func TestRateLimitForwardedFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c := defaultConfig()
+	r, err := newEngine(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.GET("/", rateLimit(1, 1), func(ctx *gin.Context) { ctx.Status(200) })
+	// a client changing its X-Forwarded-For header keeps its bucket
+	for i, want := range []int{200, 429} {
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.RemoteAddr = "192.0.2.1:1234"
+		request.Header.Set("X-Forwarded-For", fmt.Sprintf("198.51.100.%d", i+1))
+		recorder := httptest.NewRecorder()
+		r.ServeHTTP(recorder, request)
+		if recorder.Code != want {
+			t.Errorf("request %d with a spoofed X-Forwarded-For answered %d, want %d", i+1, recorder.Code, want)
+		}
+	}
+
+	// behind a trusted proxy each forwarded client has its own bucket
+	c.TrustedProxies = []string{"192.0.2.1"}
+	if r, err = newEngine(c); err != nil {
+		t.Fatal(err)
+	}
+	r.GET("/", rateLimit(1, 1), func(ctx *gin.Context) { ctx.Status(200) })
+	for i := 0; i < 2; i++ {
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.RemoteAddr = "192.0.2.1:1234"
+		request.Header.Set("X-Forwarded-For", fmt.Sprintf("198.51.100.%d", i+1))
+		recorder := httptest.NewRecorder()
+		r.ServeHTTP(recorder, request)
+		if recorder.Code != 200 {
+			t.Errorf("client %d behind the trusted proxy answered %d, want 200", i+1, recorder.Code)
+		}
+	}
+}
+
+func TestRateLimitDisabled(t *testing.T) {
+	r := limitedRouter(rateLimit(0, 0))
+	for i := 0; i < 100; i++ {
+		if response := get(r, "192.0.2.1:1234"); response.Code != 200 {
+			t.Fatalf("request %d answered %d without a limit", i+1, response.Code)
+		}
+	}
+}
+
+func TestLimitSolves(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	r := limitedRouter(limitSolves(transport.NewSemaphore(1)), func(ctx *gin.Context) {
+		close(started)
+		<-release
+	})
+	done := make(chan int)
+	go func() { done <- get(r, "192.0.2.1:1234").Code }()
+	<-started
+	if response := get(r, "192.0.2.2:1234"); response.Code != 429 {
+		t.Errorf("request during a solve answered %d, want 429", response.Code)
+	}
+	close(release)
+	if code := <-done; code != 200 {
+		t.Errorf("solving request answered %d, want 200", code)
+	}
+}
+
+func TestLimitBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/", limitBody(8), func(ctx *gin.Context) {
+		if _, err := ctx.GetRawData(); err != nil && bodyTooLarge(ctx, err) {
+			return
+		}
+		ctx.Status(200)
+	})
+	for body, want := range map[string]int{"small": 200, "larger than the limit": 413} {
+		request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+		r.ServeHTTP(recorder, request)
+		if recorder.Code != want {
+			t.Errorf("body %q answered %d, want %d", body, recorder.Code, want)
+		}
+	}
+}
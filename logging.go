@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"pnle/internal/transport"
+	"pnle/lp"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestIDHeader carries the ID of a request, it is kept when the client sends one
+const requestIDHeader = "X-Request-ID"
+
+// loggerKey is the key of the logger of a request in the gin context
+const loggerKey = "logger"
+
+// newLogger builds the logger of the server, level is trace, debug, info, warn
+// or error and format is text or json
+func newLogger(w io.Writer, level string, format string) (*slog.Logger, error) {
+	var options slog.HandlerOptions
+	switch strings.ToLower(level) {
+	case "trace":
+		options.Level = lp.LevelTrace
+	case "debug":
+		options.Level = slog.LevelDebug
+	case "info":
+		options.Level = slog.LevelInfo
+	case "warn":
+		options.Level = slog.LevelWarn
+	case "error":
+		options.Level = slog.LevelError
+	default:
+		return nil, fmt.Errorf("unknown log level %q", level)
+	}
+	// name the trace level instead of writing it as DEBUG-4
+	options.ReplaceAttr = func(groups []string, attr slog.Attr) slog.Attr {
+		if attr.Key == slog.LevelKey && attr.Value.Any() == lp.LevelTrace {
+			attr.Value = slog.StringValue("TRACE")
+		}
+		return attr
+	}
+	switch format {
+	case "text":
+		return slog.New(slog.NewTextHandler(w, &options)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, &options)), nil
+	}
+	return nil, fmt.Errorf("unknown log format %q", format)
+}
+
+// logRequests gives each request an ID and a logger carrying it, and logs the
+// request once it is served
+func logRequests(logger *slog.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := ctx.GetHeader(requestIDHeader)
+		if id == "" || len(id) > 64 {
+			id = transport.NewRequestID()
+		}
+		ctx.Header(requestIDHeader, id)
+		requestLogger := logger.With("request_id", id)
+		ctx.Set(loggerKey, requestLogger)
+		start := time.Now()
+		ctx.Next()
+		requestLogger.Info("request",
+			"method", ctx.Request.Method,
+			"path", ctx.Request.URL.Path,
+			"status", ctx.Writer.Status(),
+			"duration", time.Since(start),
+			"client", ctx.ClientIP())
+	}
+}
+
+// requestLogger returns the logger of the request, or the default logger
+func requestLogger(ctx *gin.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
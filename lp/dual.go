@@ -0,0 +1,65 @@
+package lp
+
+import "context"
+
+// Duals returns the shadow price of each constraint of the LP relaxation of lp,
+// or nil when the relaxation has no optimal solution. They are found by solving
+// the dual problem, whose variables are sign constrained by the constraint types:
+// for a maximization a <= row has a non negative dual, a >= row a non positive
+// one and an equality row a free one, the signs are swapped for a minimization
+func (lp *LinearProblem) Duals(ctx context.Context) ([]float64, error) {
+	n := len(lp.ObjectiveFunction)
+	m := len(lp.Constraints)
+	// columns[k] is the constraint and the sign of the k-th dual problem variable
+	type column struct {
+		row  int
+		sign float64
+	}
+	var columns []column
+	for i, constraintType := range lp.ConstraintTypes[:m] {
+		nonNegative := (constraintType == "<=") == lp.IsMaximization
+		switch {
+		case constraintType == "=":
+			columns = append(columns, column{i, 1}, column{i, -1})
+		case nonNegative:
+			columns = append(columns, column{i, 1})
+		default:
+			columns = append(columns, column{i, -1})
+		}
+	}
+
+	dualType := "<="
+	if lp.IsMaximization {
+		dualType = ">="
+	}
+	dual := &LinearProblem{
+		ObjectiveFunction:       make([]float64, len(columns)),
+		IsMaximization:          !lp.IsMaximization,
+		InitialConstraintLength: n,
+		InitialObjectiveLength:  len(columns),
+	}
+	for k, c := range columns {
+		dual.ObjectiveFunction[k] = c.sign * lp.Rhs[c.row]
+	}
+	for j := 0; j < n; j++ {
+		constraint := make([]float64, len(columns))
+		for k, c := range columns {
+			constraint[k] = c.sign * lp.Constraints[c.row][j]
+		}
+		dual.Constraints = append(dual.Constraints, constraint)
+		dual.ConstraintTypes = append(dual.ConstraintTypes, dualType)
+		dual.Rhs = append(dual.Rhs, lp.ObjectiveFunction[j])
+	}
+	dual.Rhs = append(dual.Rhs, 0)
+
+	// the dual is solved without observers, it is not part of the solve history
+	solution, err := dual.solveContext(ctx, SolveOptions{SkipTableaux: true})
+	if err != nil || solution == nil {
+		return nil, err
+	}
+	duals := make([]float64, m)
+	for k, c := range columns {
+		duals[c.row] += c.sign * solution.OptimalVariableValues[k]
+	}
+	return duals, nil
+}
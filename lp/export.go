@@ -0,0 +1,465 @@
+package lp
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// LaTeX writes a standalone LaTeX document of the last solve of ilp: the
+// problem, the simplex tableaux of every node as tabular environments, the
+// branch and bound tree as a TikZ picture and the solution
+func (ilp *IntegerLineaProblem) LaTeX() string {
+	var sb strings.Builder
+	sb.WriteString("\\documentclass{article}\n")
+	// the T1 encoding prints the double quotes of the names straight
+	sb.WriteString("\\usepackage[T1]{fontenc}\n")
+	sb.WriteString("\\usepackage[margin=2cm]{geometry}\n")
+	sb.WriteString("\\usepackage{amsmath}\n")
+	sb.WriteString("\\usepackage{graphicx}\n")
+	sb.WriteString("\\usepackage{tikz}\n\n")
+	sb.WriteString("\\begin{document}\n\n")
+
+	sb.WriteString("\\section*{Problem}\n")
+	sb.WriteString(ilp.InitialProblem.LaTeX())
+
+	sb.WriteString("\n\\section*{Simplex tableaux}\n")
+	for _, id := range ilp.tableauNodes() {
+		sb.WriteString(fmt.Sprintf("\n\\subsection*{Node %d}\n", id))
+		for _, tableau := range ilp.NodeTableaux[id] {
+			sb.WriteString(tableau.LaTeX())
+		}
+	}
+
+	if ilp.Tree != nil {
+		sb.WriteString("\n\\section*{Branch and bound tree}\n")
+		sb.WriteString("\\begin{center}\n")
+		// only the trees wider than the page are scaled down
+		sb.WriteString("\\resizebox{\\ifdim\\width>\\textwidth\\textwidth\\else\\width\\fi}{!}{%\n")
+		sb.WriteString(ilp.Tree.TikZ())
+		sb.WriteString("}\n\\end{center}\n")
+	}
+
+	sb.WriteString("\n\\section*{Solution}\n")
+	sb.WriteString(fmt.Sprintf("Status: %s.\n\n", latexEscape(string(ilp.Status))))
+	if !ilp.HasSolution {
+		sb.WriteString("No integer solution was found.\n")
+	} else {
+		var values []string
+		for j, value := range ilp.OptimalVariableValues {
+			values = append(values, fmt.Sprintf("x_{%d} = %s", j+1, latexValue(valueToFraction(value))))
+		}
+		values = append(values, "Z = "+latexValue(valueToFraction(ilp.OptimalObjectiveFunctionValue)))
+		sb.WriteString("\\[\n" + strings.Join(values, ",\\quad ") + "\n\\]\n")
+		sb.WriteString(fmt.Sprintf("Explored nodes: %d, dual bound: %v, gap: %v (%.2f\\%%).\n",
+			ilp.Nodes, ilp.DualBound, ilp.AbsoluteGap, ilp.RelativeGap*100))
+	}
+	sb.WriteString("\n\\end{document}\n")
+	return sb.String()
+}
+
+// LaTeX writes the problem as an align* environment, the LaTeX counterpart of
+// CreateMarkdownExpression
+func (lp *LinearProblem) LaTeX() string {
+	var sb strings.Builder
+	sense := "Maximize"
+	if !lp.IsMaximization {
+		sense = "Minimize"
+	}
+	sb.WriteString("\\begin{align*}\n")
+	sb.WriteString(fmt.Sprintf("\\text{%s}\\quad & Z = %s\\\\\n", sense, latexExpression(lp.ObjectiveFunction)))
+	for i, constraint := range lp.Constraints {
+		prefix := ""
+		if i == 0 {
+			prefix = "\\text{subject to}\\quad "
+		}
+		sb.WriteString(fmt.Sprintf("%s& %s %s %s\\\\\n", prefix, latexExpression(constraint),
+			latexComparison(lp.ConstraintTypes[i]), latexValue(valueToFraction(lp.Rhs[i]))))
+	}
+	var variables, integers []string
+	for j := range lp.ObjectiveFunction {
+		variables = append(variables, fmt.Sprintf("x_{%d}", j+1))
+		if lp.isIntegerVariable(j) {
+			integers = append(integers, fmt.Sprintf("x_{%d}", j+1))
+		}
+	}
+	sb.WriteString(fmt.Sprintf("& %s \\geq 0", strings.Join(variables, ", ")))
+	if len(integers) > 0 {
+		sb.WriteString(fmt.Sprintf("\\\\\n& %s \\text{ integer}", strings.Join(integers, ", ")))
+	}
+	sb.WriteString("\n\\end{align*}\n")
+	return sb.String()
+}
+
+// LaTeX writes the tableau as a tabular environment, with its pivot element
+// boxed, its ratio test and its explanation
+func (simplexTableau *SimplexTableau) LaTeX() string {
+	var sb strings.Builder
+	hasPivot := simplexTableau.PivotColumn >= 0
+	columns := len(simplexTableau.Headers) - 2
+	sb.WriteString("\\begin{center}\n")
+	sb.WriteString(fmt.Sprintf("\\textbf{Phase %d, iteration %d}\\\\[4pt]\n", simplexTableau.Phase, simplexTableau.Iteration))
+	layout := "c|" + strings.Repeat("c", columns) + "|c"
+	if hasPivot {
+		layout += "|c"
+	}
+	sb.WriteString(fmt.Sprintf("\\begin{tabular}{%s}\n\\hline\n", layout))
+	// the first header is the base variables column and the last one the right hand side
+	cells := []string{latexEscape(simplexTableau.Headers[0])}
+	for _, header := range simplexTableau.Headers[1 : columns+1] {
+		cells = append(cells, "$"+latexVariable(header)+"$")
+	}
+	cells = append(cells, latexEscape(simplexTableau.Headers[columns+1]))
+	if hasPivot {
+		cells = append(cells, "Ratio")
+	}
+	sb.WriteString(strings.Join(cells, " & ") + " \\\\\n\\hline\n")
+	for i, row := range simplexTableau.Tableau {
+		if i == len(simplexTableau.Tableau)-1 {
+			// the last row is the objective
+			sb.WriteString("\\hline\n")
+		}
+		cells := []string{"$" + latexVariable(simplexTableau.BaseVariables[i]) + "$"}
+		for j, value := range row {
+			cell := latexValue(value)
+			if i == simplexTableau.PivotRow && j == simplexTableau.PivotColumn {
+				cell = "\\boxed{" + cell + "}"
+			}
+			cells = append(cells, "$"+cell+"$")
+		}
+		if hasPivot {
+			ratio := ""
+			if i < len(simplexTableau.Ratios) && simplexTableau.Ratios[i] != "" {
+				ratio = "$" + latexValue(simplexTableau.Ratios[i]) + "$"
+			}
+			cells = append(cells, ratio)
+		}
+		sb.WriteString(strings.Join(cells, " & ") + " \\\\\n")
+	}
+	sb.WriteString("\\hline\n\\end{tabular}\n")
+	if simplexTableau.Explanation != "" {
+		sb.WriteString("\\\\[4pt]\n" + latexEscape(simplexTableau.Explanation) + "\n")
+	}
+	sb.WriteString("\\end{center}\n")
+	return sb.String()
+}
+
+// WriteCSV writes three tables separated by an empty line: the status of the
+// last solve, the values of the variables and of the objective, then every
+// simplex tableau of every node, each one after its header line
+func (ilp *IntegerLineaProblem) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"status", "nodes", "dual_bound", "absolute_gap", "relative_gap"})
+	writer.Write([]string{
+		string(ilp.Status),
+		strconv.Itoa(ilp.Nodes),
+		strconv.FormatFloat(ilp.DualBound, 'g', -1, 64),
+		strconv.FormatFloat(ilp.AbsoluteGap, 'g', -1, 64),
+		strconv.FormatFloat(ilp.RelativeGap, 'g', -1, 64),
+	})
+	writer.Write(nil)
+	writer.Write([]string{"variable", "value"})
+	if ilp.HasSolution {
+		for j, value := range ilp.OptimalVariableValues {
+			writer.Write([]string{fmt.Sprintf("x%d", j+1), strconv.FormatFloat(value, 'g', -1, 64)})
+		}
+		writer.Write([]string{"Z", strconv.FormatFloat(ilp.OptimalObjectiveFunctionValue, 'g', -1, 64)})
+	}
+	for _, id := range ilp.tableauNodes() {
+		for _, tableau := range ilp.NodeTableaux[id] {
+			writer.Write(nil)
+			writer.Write(append([]string{"node", "phase", "iteration"}, tableau.Headers...))
+			for i, row := range tableau.Tableau {
+				record := []string{strconv.Itoa(id), strconv.Itoa(int(tableau.Phase)), strconv.Itoa(int(tableau.Iteration)), tableau.BaseVariables[i]}
+				writer.Write(append(record, row...))
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// HTML writes a self-contained HTML report of the last solve of ilp, with the
+// problem, the solution, the branch and bound tree and the simplex tableaux of
+// every node, it needs no script nor stylesheet from elsewhere
+func (ilp *IntegerLineaProblem) HTML() (string, error) {
+	var sb strings.Builder
+	err := htmlReport.Execute(&sb, ilp)
+	return sb.String(), err
+}
+
+// ProblemHTML writes the problem of ilp as it is shown in the HTML report,
+// without the page around it
+func (ilp *IntegerLineaProblem) ProblemHTML() (string, error) {
+	var sb strings.Builder
+	err := htmlReport.ExecuteTemplate(&sb, "problem", ilp)
+	return sb.String(), err
+}
+
+// SolutionHTML writes the table of the values of the variables and of the
+// objective found by the last solve of ilp, it is empty without a solution
+func (ilp *IntegerLineaProblem) SolutionHTML() (string, error) {
+	var sb strings.Builder
+	err := htmlReport.ExecuteTemplate(&sb, "solution", ilp)
+	return sb.String(), err
+}
+
+var htmlReport = template.Must(template.New("report").Funcs(template.FuncMap{
+	"variable": htmlVariable,
+	"name": func(j int) template.HTML {
+		return htmlVariable(fmt.Sprintf("x%d", j+1))
+	},
+	"expression": htmlExpression,
+	"comparison": htmlComparison,
+	"fraction":   valueToFraction,
+	"percent": func(value float64) string {
+		return fmt.Sprintf("%.2f%%", value*100)
+	},
+	"nodes": func(ilp *IntegerLineaProblem) []int {
+		return ilp.tableauNodes()
+	},
+	"tree": func(tree *BranchAndBoundTree) template.HTML {
+		return template.HTML(tree.htmlList())
+	},
+	"last": func(i int, rows [][]string) bool {
+		return i == len(rows)-1
+	},
+	"variables": func(problem LinearProblem) template.HTML {
+		return problemVariables(problem, false)
+	},
+	"integers": func(problem LinearProblem) template.HTML {
+		return problemVariables(problem, true)
+	},
+}).Parse(htmlTemplate))
+
+const htmlTemplate = `{{define "problem"}}{{with .InitialProblem}}<div class="problem">
+<p>{{if .IsMaximization}}Maximize{{else}}Minimize{{end}} Z = {{expression .ObjectiveFunction}}</p>
+<p>subject to</p>
+{{range $i, $constraint := .Constraints}}<p>{{expression $constraint}} {{comparison (index $.InitialProblem.ConstraintTypes $i)}} {{fraction (index $.InitialProblem.Rhs $i)}}</p>
+{{end}}<p>{{variables .}} ≥ 0</p>
+{{with integers .}}<p>{{.}} integer</p>
+{{end}}</div>{{end}}{{end}}{{define "solution"}}{{if .HasSolution}}<table>
+<tr><th>Variable</th><th>Value</th></tr>
+{{range $j, $value := .OptimalVariableValues}}<tr><td>{{name $j}}</td><td>{{fraction $value}}</td></tr>
+{{end}}<tr class="objective"><td>Z</td><td>{{fraction .OptimalObjectiveFunctionValue}}</td></tr>
+</table>
+{{end}}{{end}}<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<title>Integer linear problem report</title>
+<style>
+body { font-family: Arial, sans-serif; color: #333; max-width: 1000px; margin: 0 auto; padding: 20px; line-height: 1.6; }
+table { border-collapse: collapse; margin: 10px 0; }
+th, td { border: 1px solid #ddd; padding: 4px 10px; text-align: right; }
+th { background-color: #f4f4f4; }
+tr.objective td, tr.objective th { border-top: 2px solid #333; }
+td.pivot { outline: 2px solid #e74c3c; font-weight: bold; }
+.problem p { margin: 2px 0; }
+.tree ul { list-style: none; padding-left: 24px; border-left: 1px dashed #aaa; }
+.tree > ul { border-left: none; padding-left: 0; }
+.node { display: inline-block; margin: 3px 0; padding: 2px 8px; border: 1px solid #aaa; border-radius: 4px; }
+.node.incumbent { border: 3px solid #333; }
+.branch { color: #7f8c8d; margin-right: 6px; }
+</style>
+</head>
+<body>
+<h1>Integer linear problem report</h1>
+<h2>Problem</h2>
+{{template "problem" .}}
+<h2>Solution</h2>
+<p>Status: {{.Status}}</p>
+{{if .HasSolution}}{{template "solution" .}}<p>Explored nodes: {{.Nodes}}, dual bound: {{.DualBound}}, gap: {{.AbsoluteGap}} ({{percent .RelativeGap}})</p>
+{{else}}<p>No integer solution was found.</p>
+{{end}}{{if .Tree}}<h2>Branch and bound tree</h2>
+<div class="tree">{{tree .Tree}}</div>
+{{end}}<h2>Simplex tableaux</h2>
+{{range $id := nodes .}}<h3>Node {{$id}}</h3>
+{{range index $.NodeTableaux $id}}{{$tableau := .}}<h4>Phase {{.Phase}}, iteration {{.Iteration}}</h4>
+<table>
+<tr>{{range .Headers}}<th>{{variable .}}</th>{{end}}{{if ge .PivotColumn 0}}<th>Ratio</th>{{end}}</tr>
+{{range $i, $row := .Tableau}}<tr{{if last $i $tableau.Tableau}} class="objective"{{end}}><th>{{variable (index $tableau.BaseVariables $i)}}</th>{{range $j, $value := $row}}<td{{if and (eq $i $tableau.PivotRow) (eq $j $tableau.PivotColumn)}} class="pivot"{{end}}>{{$value}}</td>{{end}}{{if ge $tableau.PivotColumn 0}}<td>{{if lt $i (len $tableau.Ratios)}}{{index $tableau.Ratios $i}}{{end}}</td>{{end}}</tr>
+{{end}}</table>
+{{with .Explanation}}<p>{{.}}</p>
+{{end}}{{end}}{{end}}</body>
+</html>
+`
+
+// htmlList writes the tree as nested lists, the children of a node are
+// ordered by ID
+func (t *BranchAndBoundTree) htmlList() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	children := make(map[int][]*TreeNode)
+	for _, node := range t.Nodes {
+		children[node.ParentID] = append(children[node.ParentID], node)
+	}
+	var sb strings.Builder
+	var write func(nodes []*TreeNode)
+	write = func(nodes []*TreeNode) {
+		sb.WriteString("<ul>")
+		for _, node := range nodes {
+			sb.WriteString("<li>")
+			if node.ParentID != 0 {
+				sb.WriteString(fmt.Sprintf(`<span class="branch">x<sub>%d</sub> %s %s</span>`, node.BranchVariable+1,
+					htmlComparison(node.BranchDirection), valueToFraction(node.BranchBound)))
+			}
+			class := "node"
+			if node.Incumbent {
+				class += " incumbent"
+			}
+			sb.WriteString(fmt.Sprintf(`<span class="%s" style="background-color: %s">%s</span>`,
+				class, node.Status.color(), template.HTMLEscapeString(node.label(", "))))
+			if len(children[node.ID]) > 0 {
+				write(children[node.ID])
+			}
+			sb.WriteString("</li>")
+		}
+		sb.WriteString("</ul>")
+	}
+	write(children[0])
+	return sb.String()
+}
+
+// tableauNodes returns the IDs of the nodes that have tableaux, in order
+func (ilp *IntegerLineaProblem) tableauNodes() []int {
+	ids := make([]int, 0, len(ilp.NodeTableaux))
+	for id := range ilp.NodeTableaux {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+var indexedVariable = regexp.MustCompile(`^([A-Za-z]+)(\d+)$`)
+
+// htmlVariable writes the index of a variable name such as x1 as a subscript
+func htmlVariable(name string) template.HTML {
+	if match := indexedVariable.FindStringSubmatch(name); match != nil {
+		return template.HTML(fmt.Sprintf("%s<sub>%s</sub>", match[1], match[2]))
+	}
+	return template.HTML(template.HTMLEscapeString(name))
+}
+
+// htmlExpression writes the linear expression of coefficients
+func htmlExpression(coefficients []float64) template.HTML {
+	var sb strings.Builder
+	for j, coefficient := range coefficients {
+		value := valueToFraction(coefficient)
+		if value == "0" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(value, "-"):
+			sb.WriteString(" − ")
+			value = value[1:]
+		case sb.Len() > 0:
+			sb.WriteString(" + ")
+		}
+		if value != "1" {
+			sb.WriteString(value)
+		}
+		sb.WriteString(string(htmlVariable(fmt.Sprintf("x%d", j+1))))
+	}
+	if sb.Len() == 0 {
+		return "0"
+	}
+	return template.HTML(strings.TrimPrefix(sb.String(), " "))
+}
+
+func htmlComparison(constraintType string) string {
+	switch constraintType {
+	case "<=":
+		return "≤"
+	case ">=":
+		return "≥"
+	}
+	return "="
+}
+
+// problemVariables lists the variables of problem, only the integer ones when
+// integers is set
+func problemVariables(problem LinearProblem, integers bool) template.HTML {
+	var names []string
+	for j := range problem.ObjectiveFunction {
+		if !integers || problem.isIntegerVariable(j) {
+			names = append(names, string(htmlVariable(fmt.Sprintf("x%d", j+1))))
+		}
+	}
+	return template.HTML(strings.Join(names, ", "))
+}
+
+// latexVariable writes the index of a variable name such as x1 as a subscript
+func latexVariable(name string) string {
+	if match := indexedVariable.FindStringSubmatch(name); match != nil {
+		return fmt.Sprintf("%s_{%s}", match[1], match[2])
+	}
+	if len(name) == 1 {
+		return name
+	}
+	return "\\text{" + latexEscape(name) + "}"
+}
+
+// latexValue writes a value of valueToFraction, such as -3/2, in math mode
+func latexValue(value string) string {
+	sign := ""
+	if strings.HasPrefix(value, "-") {
+		sign, value = "-", value[1:]
+	}
+	if numerator, denominator, ok := strings.Cut(value, "/"); ok {
+		return fmt.Sprintf("%s\\frac{%s}{%s}", sign, numerator, denominator)
+	}
+	return sign + value
+}
+
+// latexExpression writes the linear expression of coefficients in math mode
+func latexExpression(coefficients []float64) string {
+	var sb strings.Builder
+	for j, coefficient := range coefficients {
+		value := valueToFraction(coefficient)
+		if value == "0" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(value, "-"):
+			sb.WriteString(" - ")
+			value = value[1:]
+		case sb.Len() > 0:
+			sb.WriteString(" + ")
+		}
+		if value != "1" {
+			sb.WriteString(latexValue(value))
+		}
+		sb.WriteString(fmt.Sprintf("x_{%d}", j+1))
+	}
+	if sb.Len() == 0 {
+		return "0"
+	}
+	return strings.TrimPrefix(sb.String(), " ")
+}
+
+func latexComparison(constraintType string) string {
+	switch constraintType {
+	case "<=":
+		return "\\leq"
+	case ">=":
+		return "\\geq"
+	}
+	return "="
+}
+
+var latexReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\&`, `%`, `\%`, `$`, `\$`, `#`, `\#`, `_`, `\_`, `{`, `\{`, `}`, `\}`,
+	`~`, `\textasciitilde{}`, `^`, `\textasciicircum{}`, `<`, `\textless{}`, `>`, `\textgreater{}`,
+)
+
+// latexEscape escapes the characters of text that LaTeX reads as commands
+func latexEscape(text string) string {
+	return latexReplacer.Replace(text)
+}
@@ -0,0 +1,111 @@
+package lp
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "write the golden files of the exports")
+
+// exportCases are the problems whose exports are compared to the files of
+// testdata/export
+var exportCases = []struct {
+	name    string
+	problem string
+	status  SolveStatus
+}{
+	{"optimal", "max 5 4\n6 4 <= 24\n1 2 <= 6", StatusOptimal},
+	{"infeasible", "max 1 1\n1 1 <= 1\n1 1 >= 2", StatusInfeasible},
+	{"unbounded", "max 1 1\n1 -1 <= 1", StatusUnbounded},
+}
+
+// namesTableau has variable names and an explanation made of the characters
+// the exports must escape
+var namesTableau = &SimplexTableau{
+	Phase:         2,
+	Iteration:     1,
+	BaseVariables: []string{"s_1", "<b>", "Z"},
+	Headers:       []string{"Basic", "x1", "50%", `a&"b"`, "s_1", "RHS"},
+	Tableau: [][]string{
+		{"1", "0", "1/2", "1", "3"},
+		{"0", "1", "-3/2", "0", "1"},
+		{"0", "0", "2", "1", "15"},
+	},
+	PivotRow:    0,
+	PivotColumn: 2,
+	Ratios:      []string{"6", ""},
+	Explanation: "a&\"b\" enters, $s_1$ leaves <at 50%>, {#~^\\}",
+}
+
+// checkGolden compares output to the file testdata/export/name, which -update
+// writes instead
+func checkGolden(t *testing.T, name string, output []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", "export", name)
+	if *update {
+		if err := os.WriteFile(path, output, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(output, want) {
+		t.Errorf("%s differs from the golden file, run go test -update to see the changes with git diff\n%s", name, output)
+	}
+}
+
+// checkExports compares the LaTeX, CSV and HTML exports of problem to the
+// golden files name.tex, name.csv and name.html
+func checkExports(t *testing.T, name string, problem *IntegerLineaProblem) {
+	t.Helper()
+	checkGolden(t, name+".tex", []byte(problem.LaTeX()))
+	var csv bytes.Buffer
+	if err := problem.WriteCSV(&csv); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, name+".csv", csv.Bytes())
+	html, err := problem.HTML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, name+".html", []byte(html))
+}
+
+func solveExportCase(t *testing.T, text string) *IntegerLineaProblem {
+	t.Helper()
+	problem, err := ParseIntegerLinearProblem(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := DefaultSolveOptions()
+	options.Workers = 1
+	if _, err := problem.SolveContext(context.Background(), options); err != nil {
+		t.Fatal(err)
+	}
+	return problem
+}
+
+func TestExports(t *testing.T) {
+	for _, test := range exportCases {
+		t.Run(test.name, func(t *testing.T) {
+			problem := solveExportCase(t, test.problem)
+			if problem.Status != test.status {
+				t.Fatalf("status %s, want %s", problem.Status, test.status)
+			}
+			checkExports(t, test.name, problem)
+		})
+	}
+}
+
+func TestExportsEscapeNames(t *testing.T) {
+	problem := solveExportCase(t, exportCases[0].problem)
+	problem.NodeTableaux = map[int][]*SimplexTableau{1: {namesTableau}}
+	checkExports(t, "names", problem)
+}
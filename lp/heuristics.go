@@ -0,0 +1,405 @@
+package lp
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// PrimalHeuristic tries to build an integer feasible solution of a node problem
+// from the solution of its relaxation, it returns nil when it does not find one.
+// The linear problems it solves must use options, which record no tableaux
+type PrimalHeuristic interface {
+	Name() string
+	Run(ctx context.Context, options SolveOptions, problem *LinearProblem, relaxation *LinearProblem) *LinearProblem
+}
+
+const (
+	maxShiftingIterations = 100
+	maxDivingDepth        = 50
+	maxPumpIterations     = 30
+)
+
+// DefaultHeuristics returns every primal heuristic, cheapest first
+func DefaultHeuristics() []PrimalHeuristic {
+	return []PrimalHeuristic{
+		SimpleRounding{},
+		Shifting{},
+		FractionalDiving(),
+		CoefficientDiving(),
+		FeasibilityPump{},
+	}
+}
+
+// SimpleRounding rounds every fractional variable in a direction in which it
+// cannot violate any constraint
+type SimpleRounding struct{}
+
+func (SimpleRounding) Name() string {
+	return "simple rounding"
+}
+
+func (SimpleRounding) Run(ctx context.Context, options SolveOptions, problem *LinearProblem, relaxation *LinearProblem) *LinearProblem {
+	values := relaxationValues(problem, relaxation)
+	for j, value := range values {
+		if !problem.isIntegerVariable(j) {
+			continue
+		}
+		if isInteger(value) {
+			values[j] = math.Round(value)
+			continue
+		}
+		downLocks, upLocks := variableLocks(problem, j)
+		if downLocks == 0 {
+			values[j] = math.Floor(value)
+		} else if upLocks == 0 {
+			values[j] = math.Ceil(value)
+		} else {
+			return nil
+		}
+	}
+	return newHeuristicSolution(problem, values)
+}
+
+// Shifting rounds every fractional variable to its nearest integer, then shifts
+// single variables by one unit as long as it reduces the total violation
+type Shifting struct{}
+
+func (Shifting) Name() string {
+	return "shifting"
+}
+
+func (Shifting) Run(ctx context.Context, options SolveOptions, problem *LinearProblem, relaxation *LinearProblem) *LinearProblem {
+	values := roundValues(problem, relaxationValues(problem, relaxation))
+	violation := totalViolation(problem, values)
+	for i := 0; i < maxShiftingIterations && violation > tolerance; i++ {
+		bestIndex, bestShift := -1, 0.0
+		bestViolation := violation
+		for j := range values {
+			if !problem.isIntegerVariable(j) {
+				continue
+			}
+			for _, shift := range []float64{-1, 1} {
+				if values[j]+shift < 0 {
+					continue
+				}
+				values[j] += shift
+				if shiftedViolation := totalViolation(problem, values); shiftedViolation < bestViolation-tolerance {
+					bestIndex, bestShift, bestViolation = j, shift, shiftedViolation
+				}
+				values[j] -= shift
+			}
+		}
+		if bestIndex == -1 {
+			return nil
+		}
+		values[bestIndex] += bestShift
+		violation = bestViolation
+	}
+	return newHeuristicSolution(problem, values)
+}
+
+// DivingHeuristic repeatedly bounds one fractional variable and solves the
+// relaxation again until it becomes integral
+type DivingHeuristic struct {
+	name string
+	// selectVariable returns the variable to bound and whether to round it up
+	selectVariable func(problem *LinearProblem, values []float64) (int, bool)
+}
+
+// FractionalDiving rounds the variable that is closest to an integer
+func FractionalDiving() DivingHeuristic {
+	return DivingHeuristic{
+		name: "fractional diving",
+		selectVariable: func(problem *LinearProblem, values []float64) (int, bool) {
+			bestIndex, bestFractionality := -1, math.Inf(1)
+			for j, value := range values {
+				if !problem.isIntegerVariable(j) || isInteger(value) {
+					continue
+				}
+				if fractionality := evaluateBranchingCandidate(value); fractionality < bestFractionality {
+					bestIndex, bestFractionality = j, fractionality
+				}
+			}
+			return bestIndex, values[bestIndex]-math.Floor(values[bestIndex]) >= 0.5
+		},
+	}
+}
+
+// CoefficientDiving rounds the variable with the fewest locks in its rounding
+// direction, that is the one least likely to make the relaxation infeasible
+func CoefficientDiving() DivingHeuristic {
+	return DivingHeuristic{
+		name: "coefficient diving",
+		selectVariable: func(problem *LinearProblem, values []float64) (int, bool) {
+			bestIndex, bestLocks, bestFractionality := -1, math.MaxInt, math.Inf(1)
+			bestRoundUp := false
+			for j, value := range values {
+				if !problem.isIntegerVariable(j) || isInteger(value) {
+					continue
+				}
+				downLocks, upLocks := variableLocks(problem, j)
+				locks, roundUp := downLocks, false
+				if upLocks < downLocks || (upLocks == downLocks && value-math.Floor(value) >= 0.5) {
+					locks, roundUp = upLocks, true
+				}
+				fractionality := evaluateBranchingCandidate(value)
+				if locks < bestLocks || (locks == bestLocks && fractionality < bestFractionality) {
+					bestIndex, bestLocks, bestFractionality, bestRoundUp = j, locks, fractionality, roundUp
+				}
+			}
+			return bestIndex, bestRoundUp
+		},
+	}
+}
+
+func (h DivingHeuristic) Name() string {
+	return h.name
+}
+
+func (h DivingHeuristic) Run(ctx context.Context, options SolveOptions, problem *LinearProblem, relaxation *LinearProblem) *LinearProblem {
+	currentProblem, solution := problem, relaxation
+	for depth := 0; depth < maxDivingDepth; depth++ {
+		if isIntegerSolution(*solution) {
+			return solution
+		}
+		boundIndex, roundUp := h.selectVariable(currentProblem, solution.OptimalVariableValues)
+		value := solution.OptimalVariableValues[boundIndex]
+		child := roundVariable(currentProblem, boundIndex, value, roundUp)
+		childSolution, err := child.solveContext(ctx, options)
+		if err != nil {
+			return nil
+		}
+		if childSolution == nil {
+			// backtrack once by rounding the variable the other way
+			child = roundVariable(currentProblem, boundIndex, value, !roundUp)
+			childSolution, err = child.solveContext(ctx, options)
+			if err != nil || childSolution == nil {
+				return nil
+			}
+		}
+		currentProblem, solution = child, childSolution
+	}
+	return nil
+}
+
+func roundVariable(problem *LinearProblem, index int, value float64, roundUp bool) *LinearProblem {
+	if roundUp {
+		return addBoundConstraint(problem, index, ">=", math.Ceil(value))
+	}
+	return addBoundConstraint(problem, index, "<=", math.Floor(value))
+}
+
+// FeasibilityPump alternates between rounding the relaxation solution and
+// solving the LP that finds the closest point of the relaxation to the rounding
+type FeasibilityPump struct{}
+
+func (FeasibilityPump) Name() string {
+	return "feasibility pump"
+}
+
+func (FeasibilityPump) Run(ctx context.Context, options SolveOptions, problem *LinearProblem, relaxation *LinearProblem) *LinearProblem {
+	current := relaxationValues(problem, relaxation)
+	rounded := roundValues(problem, current)
+	var previous []float64
+	for i := 0; i < maxPumpIterations; i++ {
+		if isFeasiblePoint(problem, rounded) {
+			return newHeuristicSolution(problem, rounded)
+		}
+		if previous != nil && equalValues(previous, rounded) {
+			flipRoundedValues(problem, current, rounded)
+		}
+		previous = rounded
+		solution, err := distanceProblem(problem, rounded).solveContext(ctx, options)
+		if err != nil || solution == nil {
+			return nil
+		}
+		current = solution.OptimalVariableValues[:len(problem.ObjectiveFunction)]
+		rounded = roundValues(problem, current)
+	}
+	return nil
+}
+
+// distanceProblem builds the LP minimizing the L1 distance between the
+// relaxation of problem and the rounded point, using one deviation variable
+// d_j >= |x_j - rounded_j| per variable, continuous variables do not count in
+// the distance
+func distanceProblem(problem *LinearProblem, rounded []float64) *LinearProblem {
+	n := len(problem.ObjectiveFunction)
+	m := len(problem.Constraints)
+	objectiveFunction := make([]float64, 2*n)
+	var constraints [][]float64
+	var constraintTypes []string
+	var rhs []float64
+	for i, constraint := range problem.Constraints {
+		row := make([]float64, 2*n)
+		copy(row, constraint)
+		constraints = append(constraints, row)
+		constraintTypes = append(constraintTypes, problem.ConstraintTypes[i])
+		rhs = append(rhs, problem.Rhs[i])
+	}
+	for j := 0; j < n; j++ {
+		if problem.isIntegerVariable(j) {
+			objectiveFunction[n+j] = 1
+		}
+		upper := make([]float64, 2*n)
+		upper[j], upper[n+j] = 1, -1
+		lower := make([]float64, 2*n)
+		lower[j], lower[n+j] = 1, 1
+		constraints = append(constraints, upper, lower)
+		constraintTypes = append(constraintTypes, "<=", ">=")
+		rhs = append(rhs, rounded[j], rounded[j])
+	}
+	rhs = append(rhs, 0)
+	return &LinearProblem{
+		ObjectiveFunction:       objectiveFunction,
+		IsMaximization:          false,
+		Constraints:             constraints,
+		ConstraintTypes:         constraintTypes,
+		Rhs:                     rhs,
+		InitialConstraintLength: m + 2*n,
+		InitialObjectiveLength:  2 * n,
+	}
+}
+
+// flipRoundedValues moves the rounded variables that are the farthest from the
+// relaxation solution to their other neighbouring integer, to leave a cycle
+func flipRoundedValues(problem *LinearProblem, current, rounded []float64) {
+	var indexes []int
+	for j := range current {
+		if problem.isIntegerVariable(j) {
+			indexes = append(indexes, j)
+		}
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return math.Abs(current[indexes[a]]-rounded[indexes[a]]) > math.Abs(current[indexes[b]]-rounded[indexes[b]])
+	})
+	flips := (len(indexes) + 2) / 3
+	for _, j := range indexes[:flips] {
+		if rounded[j] > current[j] && rounded[j] >= 1 {
+			rounded[j]--
+		} else {
+			rounded[j]++
+		}
+	}
+}
+
+// newHeuristicSolution wraps an integer point of problem into a solved
+// LinearProblem
+func newHeuristicSolution(problem *LinearProblem, values []float64) *LinearProblem {
+	if !isFeasiblePoint(problem, values) {
+		return nil
+	}
+	return &LinearProblem{
+		ObjectiveFunction:             problem.ObjectiveFunction,
+		Constraints:                   problem.Constraints,
+		ConstraintTypes:               problem.ConstraintTypes,
+		Rhs:                           problem.Rhs,
+		IsMaximization:                problem.IsMaximization,
+		InitialConstraintLength:       problem.InitialConstraintLength,
+		InitialObjectiveLength:        problem.InitialObjectiveLength,
+		OriginalProblem:               problem,
+		OptimalVariableValues:         values,
+		OptimalObjectiveFunctionValue: objectiveValue(problem, values),
+		HasSolution:                   true,
+		Continuous:                    problem.Continuous,
+	}
+}
+
+func relaxationValues(problem *LinearProblem, relaxation *LinearProblem) []float64 {
+	values := make([]float64, len(problem.ObjectiveFunction))
+	copy(values, relaxation.OptimalVariableValues)
+	return values
+}
+
+// roundValues rounds the integer variables of problem to their nearest integer
+func roundValues(problem *LinearProblem, values []float64) []float64 {
+	rounded := make([]float64, len(values))
+	for j, value := range values {
+		rounded[j] = value
+		if problem.isIntegerVariable(j) {
+			rounded[j] = math.Round(value)
+		}
+	}
+	return rounded
+}
+
+func equalValues(a, b []float64) bool {
+	for j := range a {
+		if math.Abs(a[j]-b[j]) > tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+// variableLocks counts the constraints that may become violated when the
+// variable is rounded down and when it is rounded up
+func variableLocks(problem *LinearProblem, index int) (int, int) {
+	downLocks, upLocks := 0, 0
+	for i, constraint := range problem.Constraints {
+		value := constraint[index]
+		if value == 0 {
+			continue
+		}
+		switch problem.ConstraintTypes[i] {
+		case "<=":
+			if value > 0 {
+				upLocks++
+			} else {
+				downLocks++
+			}
+		case ">=":
+			if value > 0 {
+				downLocks++
+			} else {
+				upLocks++
+			}
+		default:
+			downLocks++
+			upLocks++
+		}
+	}
+	return downLocks, upLocks
+}
+
+func objectiveValue(problem *LinearProblem, values []float64) float64 {
+	value := 0.0
+	for j, coefficient := range problem.ObjectiveFunction {
+		value += coefficient * values[j]
+	}
+	return value
+}
+
+// totalViolation sums by how much the point violates each constraint and the
+// non negativity of the variables
+func totalViolation(problem *LinearProblem, values []float64) float64 {
+	violation := 0.0
+	for _, value := range values {
+		violation += math.Max(0, -value)
+	}
+	for i, constraint := range problem.Constraints {
+		lhs := 0.0
+		for j, coefficient := range constraint {
+			lhs += coefficient * values[j]
+		}
+		switch problem.ConstraintTypes[i] {
+		case "<=":
+			violation += math.Max(0, lhs-problem.Rhs[i])
+		case ">=":
+			violation += math.Max(0, problem.Rhs[i]-lhs)
+		default:
+			violation += math.Abs(lhs - problem.Rhs[i])
+		}
+	}
+	return violation
+}
+
+func isFeasiblePoint(problem *LinearProblem, values []float64) bool {
+	for j, value := range values {
+		if problem.isIntegerVariable(j) && !isInteger(value) {
+			return false
+		}
+	}
+	return totalViolation(problem, values) < tolerance
+}
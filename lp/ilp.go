@@ -1,10 +1,11 @@
 package lp
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"math"
 	"pnle/utils"
+	"sync"
+	"time"
 )
 
 type IntegerLineaProblem struct {
@@ -12,100 +13,635 @@ type IntegerLineaProblem struct {
 	HasSolution                   bool
 	OptimalVariableValues         []float64
 	OptimalObjectiveFunctionValue float64
+	Status                        SolveStatus
+	// DualBound is the best objective value that the open nodes could still reach,
+	// it is set even without an incumbent, 0 when it is infinite
+	DualBound   float64
+	AbsoluteGap float64
+	RelativeGap float64
+	Nodes       int
+	// Tree records the nodes explored by the last solve
+	Tree *BranchAndBoundTree
+	// NodeTableaux holds the simplex history of every solved node, keyed by node ID
+	NodeTableaux map[int][]*SimplexTableau
+	// IncumbentNode is the ID of the node on which the incumbent was found
+	IncumbentNode int
+	// IncumbentSource is "branch and bound" or the name of the heuristic that
+	// found the incumbent
+	IncumbentSource string
 }
 
 const tolerance = 1e-8
 
+// branchAndBoundSource is the source of the incumbents found on the nodes
+const branchAndBoundSource = "branch and bound"
+
 func isInteger(x float64) bool {
 	return math.Abs(x-math.Round(x)) < tolerance
 }
+
+// LoadIntegerLinearProblemFromFile is ReadIntegerLinearProblemFile for valid
+// files, it panics when the file cannot be read or is malformed
 func LoadIntegerLinearProblemFromFile(filename string) *IntegerLineaProblem {
-	problem := LoadProblemFromFile(filename)
-	return &IntegerLineaProblem{
-		InitialProblem: *problem,
+	problem, err := ReadIntegerLinearProblemFile(filename)
+	if err != nil {
+		panic(err)
 	}
+	return problem
 }
+
+// CreateIntegerLinearProblem is ParseIntegerLinearProblem for valid problems,
+// it panics when the problem is malformed
 func CreateIntegerLinearProblem(content string) *IntegerLineaProblem {
-	problem := CreateProblem(content)
+	problem, err := ParseIntegerLinearProblem(content)
+	if err != nil {
+		panic(err)
+	}
+	return problem
+}
+
+// ReadIntegerLinearProblemFile reads a problem in the text format from a file
+func ReadIntegerLinearProblemFile(filename string) (*IntegerLineaProblem, error) {
+	problem, err := ReadProblemFile(filename)
+	if err != nil {
+		return nil, err
+	}
 	return &IntegerLineaProblem{
 		InitialProblem: *problem,
+	}, nil
+}
+
+// ParseIntegerLinearProblem reads a problem in the text format of ParseProblem
+func ParseIntegerLinearProblem(content string) (*IntegerLineaProblem, error) {
+	problem, err := ParseProblem(content)
+	if err != nil {
+		return nil, err
 	}
+	return &IntegerLineaProblem{
+		InitialProblem: *problem,
+	}, nil
+}
+
+// isIntegerVariable tells whether the decision variable must take an integer value
+func (lp *LinearProblem) isIntegerVariable(index int) bool {
+	return index >= len(lp.Continuous) || !lp.Continuous[index]
 }
+
 func isIntegerSolution(lp LinearProblem) bool {
-	for _, value := range lp.OptimalVariableValues {
-		if !isInteger(value) {
+	for i, value := range lp.OptimalVariableValues {
+		if lp.isIntegerVariable(i) && !isInteger(value) {
 			return false
 		}
 	}
 	return true
 }
 func (ilp *IntegerLineaProblem) Solve() *LinearProblem {
-	problemQueue := utils.NewQueue[LinearProblem]()
-	problemQueue.Enqueue(ilp.InitialProblem)
-	iteration := 1
-	isMaximization := ilp.InitialProblem.IsMaximization
-	var bestSolution *LinearProblem
-	bestValue := math.Inf(-1)
-	if !ilp.InitialProblem.IsMaximization {
-		bestValue = math.Inf(1)
+	return ilp.SolveWithOptions(DefaultSolveOptions())
+}
+
+// SolveWithOptions runs the branch and bound method, solving the node relaxations
+// on options.Workers goroutines that share the incumbent and the open nodes.
+// It returns the best incumbent, or nil when none was found before stopping
+func (ilp *IntegerLineaProblem) SolveWithOptions(options SolveOptions) *LinearProblem {
+	solution, _ := ilp.SolveContext(context.Background(), options)
+	return solution
+}
+
+// SolveContext is SolveWithOptions stopping with StatusCanceled when ctx is done,
+// in which case it returns the best incumbent along with the context error
+func (ilp *IntegerLineaProblem) SolveContext(ctx context.Context, options SolveOptions) (*LinearProblem, error) {
+	start := time.Now()
+	ilp.Tree = NewBranchAndBoundTree()
+	ilp.NodeTableaux = make(map[int][]*SimplexTableau)
+	ilp.IncumbentNode, ilp.IncumbentSource = 0, ""
+	bb := newBranchAndBound(ctx, ilp, options)
+	// wake up the workers waiting for an open node
+	stop := context.AfterFunc(ctx, func() {
+		bb.mu.Lock()
+		defer bb.mu.Unlock()
+		bb.nodeAvailable.Broadcast()
+	})
+	defer stop()
+	bb.enqueue(&branchNode{
+		problem:        ilp.InitialProblem,
+		bound:          -bb.bestValue,
+		branchVariable: -1,
+	})
+	if options.Deterministic {
+		bb.runDeterministic()
+	} else {
+		bb.runConcurrent()
+	}
+	bb.deliver()
+	if bb.bestSolution != nil && ilp.IncumbentSource != branchAndBoundSource {
+		bb.resolveHeuristicSolution()
+	}
+	bb.saveResult(ilp)
+	observeSearch(ilp, time.Since(start))
+	if ilp.Status == StatusCanceled {
+		return bb.bestSolution, ctx.Err()
+	}
+	return bb.bestSolution, nil
+}
+
+// branchNode is a node of the branch and bound tree
+type branchNode struct {
+	id       int
+	parentID int
+	depth    int
+	problem  LinearProblem
+	// bound is the relaxation value of the parent node, no solution of the
+	// subtree can do better
+	bound float64
+	// branchVariable is -1 for the root, the other nodes add the constraint
+	// x_branchVariable <branchDirection> branchBound to their parent problem
+	branchVariable  int
+	branchDirection string
+	branchBound     float64
+}
+
+func (node *branchNode) event() NodeEvent {
+	return NodeEvent{
+		ID:              node.id,
+		ParentID:        node.parentID,
+		Depth:           node.depth,
+		BranchVariable:  node.branchVariable,
+		BranchDirection: node.branchDirection,
+		BranchBound:     node.branchBound,
+		Bound:           node.bound,
 	}
+}
+
+// branchAndBound holds the state shared by the goroutines exploring the tree
+type branchAndBound struct {
+	ctx            context.Context
+	ilp            *IntegerLineaProblem
+	initialProblem *LinearProblem
+	options        SolveOptions
+	observer       SolverObserver
+	isMaximization bool
+	start          time.Time
+	mu             sync.Mutex
+	nodeAvailable  *sync.Cond
+	problemQueue   *utils.Queue[*branchNode]
+	activeNodes    map[int]*branchNode
+	createdNodes   int
+	processedNodes int
+	iteration      int
+	solutions      int
+	bestSolution   *LinearProblem
+	bestValue      float64
+	status         SolveStatus
+	// reportedGap is the last dual bound and incumbent value sent to the observers
+	reportedGap [2]float64
+	// events are the observer calls made with the lock held, deliver makes them
+	// in order once it is released so that a slow observer cannot hold the lock
+	events     []func(SolverObserver)
+	deliveryMu sync.Mutex
+}
+
+func newBranchAndBound(ctx context.Context, ilp *IntegerLineaProblem, options SolveOptions) *branchAndBound {
+	bb := &branchAndBound{
+		ctx:            ctx,
+		ilp:            ilp,
+		initialProblem: &ilp.InitialProblem,
+		options:        options,
+		observer:       observerList(append([]SolverObserver{ilp.Tree}, options.observers()...)),
+		isMaximization: ilp.InitialProblem.IsMaximization,
+		start:          time.Now(),
+		problemQueue:   utils.NewQueue[*branchNode](),
+		activeNodes:    make(map[int]*branchNode),
+		bestValue:      math.Inf(-1),
+	}
+	if !bb.isMaximization {
+		bb.bestValue = math.Inf(1)
+	}
+	bb.nodeAvailable = sync.NewCond(&bb.mu)
+	return bb
+}
+
+// enqueue adds a new open node, it must be called with the lock held
+func (bb *branchAndBound) enqueue(node *branchNode) {
+	bb.createdNodes++
+	node.id = bb.createdNodes
+	bb.problemQueue.Enqueue(node)
+	event := node.event()
+	bb.notify(func(o SolverObserver) { o.NodeCreated(event) })
+}
+
+// notify records an event for the observers, it must be called with the lock held
+func (bb *branchAndBound) notify(event func(SolverObserver)) {
+	bb.events = append(bb.events, event)
+}
+
+// deliver calls the observers with the events recorded so far, it must be
+// called without the lock. A single goroutine delivers at a time, the others
+// leave their events to it and go on with the search
+func (bb *branchAndBound) deliver() {
 	for {
-		fmt.Printf("Iteration no: %v\n", iteration)
-		currentProblem, containsElement := problemQueue.Dequeue()
-		if !containsElement {
-			break
-		}
-		solution := currentProblem.Solve()
-		if solution == nil && iteration == 1 {
-			log.Fatal("This integer linear problem have no solution")
-		}
-		if solution != nil {
-			//since we have negative optimal value for maximization and positive optimal value for minimization
-			if isMaximization && solution.OptimalObjectiveFunctionValue*-1 < bestValue {
-				continue
-			} else if !isMaximization && solution.OptimalObjectiveFunctionValue*-1 > bestValue {
-				continue
+		if !bb.deliveryMu.TryLock() {
+			return
+		}
+		bb.mu.Lock()
+		events := bb.events
+		bb.events = nil
+		bb.mu.Unlock()
+		for _, event := range events {
+			event(bb.observer)
+		}
+		bb.deliveryMu.Unlock()
+		// events recorded while delivering may have been left to this goroutine
+		bb.mu.Lock()
+		pending := len(bb.events) > 0
+		bb.mu.Unlock()
+		if !pending {
+			return
+		}
+	}
+}
+
+// branch enqueues the two children of node obtained by rounding down and up
+// the value of a fractional variable, it must be called with the lock held
+func (bb *branchAndBound) branch(node *branchNode, boundIndex int, value float64, bound float64) {
+	for _, roundUp := range []bool{false, true} {
+		child := &branchNode{
+			parentID:       node.id,
+			depth:          node.depth + 1,
+			problem:        *roundVariable(&node.problem, boundIndex, value, roundUp),
+			bound:          bound,
+			branchVariable: boundIndex,
+		}
+		last := len(child.problem.ConstraintTypes) - 1
+		child.branchDirection = child.problem.ConstraintTypes[last]
+		child.branchBound = child.problem.Rhs[last]
+		bb.enqueue(child)
+	}
+}
+
+// runConcurrent lets every worker pick the next open node as soon as it is idle
+func (bb *branchAndBound) runConcurrent() {
+	var wg sync.WaitGroup
+	for i := 0; i < bb.options.workers(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				node, ok := bb.next()
+				bb.deliver()
+				if !ok {
+					return
+				}
+				solution, err := node.problem.solveContext(bb.ctx, bb.options)
+				if err != nil {
+					bb.interrupt(node)
+					return
+				}
+				bb.process(node, solution)
 			}
-			if isIntegerSolution(*solution) {
-				bestSolution = solution
-				bestValue = solution.OptimalObjectiveFunctionValue * -1
+		}()
+	}
+	wg.Wait()
+}
+
+// runDeterministic solves the open nodes in rounds of at most options.Workers nodes,
+// then processes the solutions in queue order. The tree only depends on the
+// number of workers, a search with other workers may explore other nodes
+func (bb *branchAndBound) runDeterministic() {
+	workers := bb.options.workers()
+	for {
+		var round []*branchNode
+		bb.mu.Lock()
+		for len(round) < workers && !bb.checkLimits() {
+			node, containsElement := bb.dequeue()
+			if !containsElement {
+				break
+			}
+			round = append(round, node)
+		}
+		bb.mu.Unlock()
+		bb.deliver()
+		if len(round) == 0 {
+			return
+		}
+		solutions := make([]*LinearProblem, len(round))
+		errors := make([]error, len(round))
+		var wg sync.WaitGroup
+		for i := range round {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				solutions[i], errors[i] = round[i].problem.solveContext(bb.ctx, bb.options)
+			}(i)
+		}
+		wg.Wait()
+		for i := range round {
+			if errors[i] != nil {
+				bb.interrupt(round[i])
 				continue
-			} else {
-				boundIndex := chooseBranchingVariable(solution)
-				integerBound := int64(math.Floor(solution.OptimalVariableValues[boundIndex]))
-				// construct the constraint
-				boundConstraint := make([]float64, len(ilp.InitialProblem.ObjectiveFunction))
-				boundConstraint[boundIndex] = 1
-				// setup the lower bound value
-				lowerBoundProblem := currentProblem.Clone()
-				lowerBoundProblem.Constraints = append(lowerBoundProblem.Constraints, boundConstraint)
-				lowerBoundProblem.ConstraintTypes = append(lowerBoundProblem.ConstraintTypes, "<=")
-				lowerBoundProblem.Rhs = append(lowerBoundProblem.Rhs[:len(lowerBoundProblem.Rhs)-1],
-					float64(integerBound),
-					lowerBoundProblem.Rhs[len(lowerBoundProblem.Rhs)-1])
-				lowerBoundProblem.InitialConstraintLength += 1
-				problemQueue.Enqueue(*lowerBoundProblem)
-				// setup the upper bound value
-				upperBoundProblem := currentProblem.Clone()
-				upperBoundProblem.Constraints = append(upperBoundProblem.Constraints, boundConstraint)
-				upperBoundProblem.ConstraintTypes = append(upperBoundProblem.ConstraintTypes, ">=")
-				upperBoundProblem.Rhs = append(upperBoundProblem.Rhs[:len(upperBoundProblem.Rhs)-1],
-					float64(integerBound+1),
-					upperBoundProblem.Rhs[len(upperBoundProblem.Rhs)-1])
-				upperBoundProblem.InitialConstraintLength += 1
-				problemQueue.Enqueue(*upperBoundProblem)
 			}
+			bb.process(round[i], solutions[i])
 		}
-		iteration++
 	}
-	return bestSolution
+}
+
+// next blocks until an open node is available, it returns false once the queue
+// is empty and no other worker can add new nodes to it, or once a limit is reached
+func (bb *branchAndBound) next() (*branchNode, bool) {
+	bb.mu.Lock()
+	defer bb.mu.Unlock()
+	for bb.problemQueue.IsEmpty() && len(bb.activeNodes) > 0 && bb.status == "" {
+		bb.nodeAvailable.Wait()
+	}
+	if bb.checkLimits() {
+		return nil, false
+	}
+	return bb.dequeue()
+}
+
+// dequeue must be called with the lock held
+func (bb *branchAndBound) dequeue() (*branchNode, bool) {
+	node, containsElement := bb.problemQueue.Dequeue()
+	if !containsElement {
+		return nil, false
+	}
+	bb.activeNodes[node.id] = node
+	bb.iteration++
+	event := node.event()
+	bb.notify(func(o SolverObserver) { o.NodeStarted(event) })
+	return node, true
+}
+
+// checkLimits records the status matching the first stopping criterion that is
+// met, it must be called with the lock held
+func (bb *branchAndBound) checkLimits() bool {
+	if bb.status != "" {
+		return true
+	}
+	options := bb.options
+	if bb.ctx.Err() != nil {
+		bb.status = StatusCanceled
+	} else if options.NodeLimit > 0 && bb.iteration >= options.NodeLimit {
+		bb.status = StatusNodeLimit
+	} else if options.TimeLimit > 0 && time.Since(bb.start) >= options.TimeLimit {
+		bb.status = StatusTimeLimit
+	} else if options.SolutionLimit > 0 && bb.solutions >= options.SolutionLimit {
+		bb.status = StatusSolutionLimit
+	} else if !bb.problemQueue.IsEmpty() && bb.bestSolution != nil {
+		absoluteGap, relativeGap := bb.gap(bb.dualBound())
+		if absoluteGap <= tolerance {
+			bb.status = StatusOptimal
+		} else if relativeGap <= options.RelativeGapTolerance {
+			bb.status = StatusGapLimit
+		}
+	}
+	return bb.status != ""
+}
+
+// dualBound is the best objective value that a solution of the open nodes
+// could reach, it must be called with the lock held
+func (bb *branchAndBound) dualBound() float64 {
+	bound := bb.bestValue
+	consider := func(node *branchNode) {
+		if (bb.isMaximization && node.bound > bound) || (!bb.isMaximization && node.bound < bound) {
+			bound = node.bound
+		}
+	}
+	for _, node := range bb.problemQueue.Items() {
+		consider(node)
+	}
+	for _, node := range bb.activeNodes {
+		consider(node)
+	}
+	return bound
+}
+
+// gap returns the absolute and relative gap between the incumbent and the dual bound
+func (bb *branchAndBound) gap(dualBound float64) (float64, float64) {
+	if bb.bestSolution == nil {
+		return math.Inf(1), math.Inf(1)
+	}
+	absoluteGap := math.Abs(dualBound - bb.bestValue)
+	if absoluteGap <= tolerance {
+		return 0, 0
+	}
+	return absoluteGap, absoluteGap / math.Max(math.Abs(bb.bestValue), tolerance)
+}
+
+// saveResult stores the incumbent, the status and the gap of the search in ilp
+func (bb *branchAndBound) saveResult(ilp *IntegerLineaProblem) {
+	if bb.status == "" {
+		bb.status = StatusOptimal
+	}
+	if bb.bestSolution == nil && bb.problemQueue.IsEmpty() && bb.status != StatusUnbounded {
+		bb.status = StatusInfeasible
+	}
+	ilp.Status = bb.status
+	ilp.Nodes = bb.iteration
+	ilp.HasSolution = bb.bestSolution != nil
+	if bb.bestSolution != nil {
+		// integer variables are snapped to the integer they are within tolerance of
+		ilp.OptimalVariableValues = make([]float64, len(bb.bestSolution.OptimalVariableValues))
+		for i, value := range bb.bestSolution.OptimalVariableValues {
+			ilp.OptimalVariableValues[i] = value
+			if bb.bestSolution.isIntegerVariable(i) {
+				ilp.OptimalVariableValues[i] = math.Round(value)
+			}
+		}
+		ilp.OptimalObjectiveFunctionValue = bb.bestValue
+	}
+	// without an incumbent the bound of the open nodes is still known, but not
+	// the gaps. It is left at 0 when it is infinite, with no node left or solved
+	ilp.DualBound, ilp.AbsoluteGap, ilp.RelativeGap = bb.dualBound(), 0, 0
+	if math.IsInf(ilp.DualBound, 0) {
+		ilp.DualBound = 0
+	}
+	if bb.bestSolution != nil {
+		ilp.AbsoluteGap, ilp.RelativeGap = bb.gap(ilp.DualBound)
+	}
+	bb.observer.SearchFinished(SearchResultEvent{
+		Status:      ilp.Status,
+		HasSolution: ilp.HasSolution,
+		Value:       ilp.OptimalObjectiveFunctionValue,
+		DualBound:   ilp.DualBound,
+		AbsoluteGap: ilp.AbsoluteGap,
+		RelativeGap: ilp.RelativeGap,
+		Nodes:       ilp.Nodes,
+	})
+}
+
+// interrupt puts back a node whose relaxation was canceled, so that it still
+// counts in the dual bound
+func (bb *branchAndBound) interrupt(node *branchNode) {
+	bb.mu.Lock()
+	defer bb.mu.Unlock()
+	defer bb.nodeAvailable.Broadcast()
+	delete(bb.activeNodes, node.id)
+	bb.problemQueue.Enqueue(node)
+	bb.checkLimits()
+}
+
+// process updates the incumbent with the solution of a node relaxation, or
+// enqueues the two subproblems obtained by branching on it
+func (bb *branchAndBound) process(node *branchNode, solution *LinearProblem) {
+	runHeuristics := bb.processSolution(node, solution)
+	bb.deliver()
+	if runHeuristics {
+		bb.runHeuristics(node, solution)
+	}
+}
+
+// processSolution reports whether the primal heuristics should run on the node
+func (bb *branchAndBound) processSolution(node *branchNode, solution *LinearProblem) bool {
+	bb.mu.Lock()
+	defer bb.mu.Unlock()
+	defer bb.nodeAvailable.Broadcast()
+	defer bb.reportGap()
+	delete(bb.activeNodes, node.id)
+	bb.processedNodes++
+	bb.ilp.NodeTableaux[node.id] = node.problem.SolutionSteps
+	if solution == nil && node.problem.IsUnbounded {
+		// bound constraints cannot make a bounded relaxation unbounded, so this
+		// only happens at the root and no integer solution is optimal
+		bb.notify(func(o SolverObserver) { o.NodePruned(NodePrunedEvent{ID: node.id, Reason: PrunedUnbounded}) })
+		bb.status = StatusUnbounded
+		return false
+	}
+	if solution == nil {
+		bb.notify(func(o SolverObserver) { o.NodePruned(NodePrunedEvent{ID: node.id, Reason: PrunedInfeasible}) })
+		return false
+	}
+	value := solution.OptimalObjectiveFunctionValue
+	if (bb.isMaximization && value < bb.bestValue) || (!bb.isMaximization && value > bb.bestValue) {
+		bb.notify(func(o SolverObserver) {
+			o.NodePruned(NodePrunedEvent{ID: node.id, Reason: PrunedByBound, Value: value})
+		})
+		return false
+	}
+	if isIntegerSolution(*solution) {
+		bb.notify(func(o SolverObserver) {
+			o.NodePruned(NodePrunedEvent{ID: node.id, Reason: PrunedByIntegrality, Value: value})
+		})
+		bb.setIncumbent(solution, branchAndBoundSource, node.id)
+		return false
+	}
+	boundIndex := chooseBranchingVariable(solution)
+	bb.notify(func(o SolverObserver) {
+		o.NodeBranched(NodeBranchedEvent{ID: node.id, Value: value, BranchVariable: boundIndex})
+	})
+	bb.branch(node, boundIndex, solution.OptimalVariableValues[boundIndex], value)
+	frequency := bb.options.HeuristicFrequency
+	return bb.processedNodes == 1 || (frequency > 0 && bb.processedNodes%frequency == 0)
+}
+
+// runHeuristics runs the primal heuristics on a node without holding the lock,
+// so the other workers keep exploring the tree meanwhile
+func (bb *branchAndBound) runHeuristics(node *branchNode, solution *LinearProblem) {
+	// the many LPs of the heuristics record no tableaux, an incumbent found by a
+	// heuristic gets the tableaux of resolveHeuristicSolution
+	options := bb.options
+	options.SkipTableaux = true
+	for _, heuristic := range bb.options.Heuristics {
+		heuristicSolution := heuristic.Run(bb.ctx, options, &node.problem, solution)
+		if heuristicSolution == nil {
+			continue
+		}
+		bb.mu.Lock()
+		if bb.isImprovement(heuristicSolution.OptimalObjectiveFunctionValue) {
+			bb.setIncumbent(heuristicSolution, heuristic.Name(), node.id)
+			bb.reportGap()
+		}
+		bb.mu.Unlock()
+		bb.deliver()
+	}
+}
+
+func (bb *branchAndBound) isImprovement(value float64) bool {
+	if bb.isMaximization {
+		return value > bb.bestValue+tolerance
+	}
+	return value < bb.bestValue-tolerance
+}
+
+// reportGap tells the observers about a new dual bound or incumbent value once
+// there is an incumbent, it must be called with the lock held
+func (bb *branchAndBound) reportGap() {
+	if bb.bestSolution == nil {
+		return
+	}
+	dualBound := bb.dualBound()
+	if bb.reportedGap == [2]float64{dualBound, bb.bestValue} {
+		return
+	}
+	bb.reportedGap = [2]float64{dualBound, bb.bestValue}
+	absoluteGap, relativeGap := bb.gap(dualBound)
+	event := GapEvent{
+		Incumbent:   bb.bestValue,
+		DualBound:   dualBound,
+		AbsoluteGap: absoluteGap,
+		RelativeGap: relativeGap,
+		Nodes:       bb.processedNodes,
+		Elapsed:     time.Since(bb.start),
+	}
+	bb.notify(func(o SolverObserver) { o.GapChanged(event) })
+}
+
+// resolveHeuristicSolution replaces an incumbent found by a heuristic with the
+// solution of the root problem whose integer variables are fixed to their values
+// in it, so that it has the tableaux of a solve and the problem of the root like
+// the incumbents found on the nodes. It is called once the workers are done
+func (bb *branchAndBound) resolveHeuristicSolution() {
+	fixed := bb.initialProblem.Clone()
+	for j, value := range bb.bestSolution.OptimalVariableValues {
+		if bb.initialProblem.isIntegerVariable(j) {
+			fixed = addBoundConstraint(fixed, j, "=", math.Round(value))
+		}
+	}
+	// the fixed problem is solved even when the search was stopped by ctx
+	solution, err := fixed.solveContext(context.WithoutCancel(bb.ctx), SolveOptions{})
+	if err != nil || solution == nil {
+		return
+	}
+	solution.OriginalProblem = bb.initialProblem.Clone()
+	bb.bestSolution = solution
+	bb.bestValue = solution.OptimalObjectiveFunctionValue
+}
+
+// setIncumbent must be called with the lock held
+func (bb *branchAndBound) setIncumbent(solution *LinearProblem, source string, nodeID int) {
+	bb.bestSolution = solution
+	bb.bestValue = solution.OptimalObjectiveFunctionValue
+	bb.ilp.IncumbentNode = nodeID
+	bb.ilp.IncumbentSource = source
+	bb.solutions++
+	event := IncumbentEvent{
+		Value:  bb.bestValue,
+		Values: solution.OptimalVariableValues,
+		Source: source,
+		NodeID: nodeID,
+	}
+	bb.notify(func(o SolverObserver) { o.IncumbentFound(event) })
+}
+
+// addBoundConstraint returns a copy of problem with the constraint x_index <type> bound
+func addBoundConstraint(problem *LinearProblem, index int, constraintType string, bound float64) *LinearProblem {
+	// construct the constraint
+	boundConstraint := make([]float64, len(problem.ObjectiveFunction))
+	boundConstraint[index] = 1
+	boundProblem := problem.Clone()
+	boundProblem.Constraints = append(boundProblem.Constraints, boundConstraint)
+	boundProblem.ConstraintTypes = append(boundProblem.ConstraintTypes, constraintType)
+	boundProblem.Rhs = append(boundProblem.Rhs[:len(boundProblem.Rhs)-1],
+		bound,
+		boundProblem.Rhs[len(boundProblem.Rhs)-1])
+	boundProblem.InitialConstraintLength += 1
+	return boundProblem
 }
 
 func chooseBranchingVariable(lp *LinearProblem) int {
 	bestScore := math.Inf(-1)
 	var bestVarIndex int
 	for i, value := range lp.OptimalVariableValues {
-		if !isInteger(value) {
+		if lp.isIntegerVariable(i) && !isInteger(value) {
 			score := evaluateBranchingCandidate(value)
 			if score > bestScore {
 				bestScore = score
@@ -0,0 +1,167 @@
+package lp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+// sampleFiles are the problems of the repository with their optimal value
+var sampleFiles = []struct {
+	filename string
+	optimum  float64
+}{
+	{"../file.txt", 11},
+	{"../file2.txt", 151},
+	{"../file3.txt", 400},
+	{"../file4.txt", 23},
+	{"../file5.txt", 240},
+	{"../test.txt", 33},
+}
+
+func loadSample(t *testing.T, filename string) *IntegerLineaProblem {
+	t.Helper()
+	problem, err := ReadIntegerLinearProblemFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return problem
+}
+
+func TestSampleFiles(t *testing.T) {
+	for _, sample := range sampleFiles {
+		for _, workers := range []int{1, 4} {
+			for _, deterministic := range []bool{false, true} {
+				name := fmt.Sprintf("%s/workers=%d/deterministic=%v", sample.filename, workers, deterministic)
+				t.Run(name, func(t *testing.T) {
+					problem := loadSample(t, sample.filename)
+					options := DefaultSolveOptions()
+					options.Workers = workers
+					options.Deterministic = deterministic
+					solution, err := problem.SolveContext(context.Background(), options)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if problem.Status != StatusOptimal || solution == nil {
+						t.Fatalf("status %s, want optimal", problem.Status)
+					}
+					if math.Abs(problem.OptimalObjectiveFunctionValue-sample.optimum) > tolerance {
+						t.Errorf("Z = %v, want %v", problem.OptimalObjectiveFunctionValue, sample.optimum)
+					}
+					if problem.AbsoluteGap != 0 || problem.RelativeGap != 0 {
+						t.Errorf("gap = %v (%v), want 0", problem.AbsoluteGap, problem.RelativeGap)
+					}
+					for i, value := range problem.OptimalVariableValues {
+						if math.Abs(value-math.Round(value)) > tolerance {
+							t.Errorf("x%d = %v, want an integer", i+1, value)
+						}
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestDeterministic(t *testing.T) {
+	for _, sample := range sampleFiles {
+		var trees []string
+		for run := 0; run < 3; run++ {
+			problem := loadSample(t, sample.filename)
+			options := DefaultSolveOptions()
+			options.Workers = 4
+			options.Deterministic = true
+			problem.SolveWithOptions(options)
+			trees = append(trees, problem.Tree.DOT())
+		}
+		if trees[0] != trees[1] || trees[0] != trees[2] {
+			t.Errorf("%s: the explored trees differ between the runs", sample.filename)
+		}
+	}
+}
+
+func TestCanceled(t *testing.T) {
+	problem := loadSample(t, "../file5.txt")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	solution, err := problem.SolveContext(ctx, DefaultSolveOptions())
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+	if problem.Status != StatusCanceled || solution != nil {
+		t.Errorf("status %s with solution %v, want canceled without solution", problem.Status, solution)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		options func(*SolveOptions)
+		status  SolveStatus
+	}{
+		{"node limit", func(options *SolveOptions) { options.NodeLimit = 1 }, StatusNodeLimit},
+		{"time limit", func(options *SolveOptions) { options.TimeLimit = time.Nanosecond }, StatusTimeLimit},
+		{"solution limit", func(options *SolveOptions) { options.SolutionLimit = 1 }, StatusSolutionLimit},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			problem, err := ParseIntegerLinearProblem("max 5 4\n6 4 <= 24\n1 2 <= 6")
+			if err != nil {
+				t.Fatal(err)
+			}
+			options := DefaultSolveOptions()
+			options.Heuristics = nil
+			test.options(&options)
+			if _, err := problem.SolveContext(context.Background(), options); err != nil {
+				t.Fatal(err)
+			}
+			if problem.Status != test.status {
+				t.Errorf("status %s, want %s", problem.Status, test.status)
+			}
+		})
+	}
+}
+
+func TestDualBoundWithoutIncumbent(t *testing.T) {
+	problem, err := ParseIntegerLinearProblem("max 5 4\n6 4 <= 24\n1 2 <= 6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := DefaultSolveOptions()
+	options.Heuristics = nil
+	options.NodeLimit = 1
+	problem.SolveWithOptions(options)
+	if problem.HasSolution {
+		t.Fatal("the root relaxation is fractional, want no incumbent")
+	}
+	// the relaxation is x1 = 3, x2 = 1.5
+	if problem.DualBound != 21 || problem.AbsoluteGap != 0 || problem.RelativeGap != 0 {
+		t.Errorf("dual bound %v, gap %v (%v), want 21 without gap", problem.DualBound, problem.AbsoluteGap, problem.RelativeGap)
+	}
+}
+
+func TestHeuristicIncumbent(t *testing.T) {
+	problem, err := ParseIntegerLinearProblem("max 5 4\n6 4 <= 24\n1 2 <= 6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := DefaultSolveOptions()
+	options.Heuristics = []PrimalHeuristic{SimpleRounding{}}
+	options.NodeLimit = 1
+	solution := problem.SolveWithOptions(options)
+	if solution == nil || problem.IncumbentSource != "simple rounding" {
+		t.Fatalf("incumbent found by %q, want simple rounding", problem.IncumbentSource)
+	}
+	if problem.OptimalObjectiveFunctionValue != 19 {
+		t.Errorf("Z = %v, want 19", problem.OptimalObjectiveFunctionValue)
+	}
+	if len(solution.SolutionSteps) == 0 {
+		t.Error("the heuristic solution has no tableaux")
+	}
+	if len(solution.OriginalProblem.Constraints) != len(problem.InitialProblem.Constraints) {
+		t.Errorf("the problem of the solution has %d constraints, want the %d of the root",
+			len(solution.OriginalProblem.Constraints), len(problem.InitialProblem.Constraints))
+	}
+}
@@ -0,0 +1,84 @@
+package lp
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LevelTrace is below slog.LevelDebug, the simplex pivots are logged at this level
+const LevelTrace = slog.Level(-8)
+
+// logObserver logs the events of the solvers: the pivots at LevelTrace, the
+// tableaux and the nodes at debug level and the outcome of the search at info level
+type logObserver struct {
+	logger *slog.Logger
+}
+
+func (o logObserver) PhaseChanged(event PhaseEvent) {
+	o.logger.Debug("simplex phase started", "phase", event.Phase, "tableau", event.Tableau.String())
+}
+
+func (o logObserver) PivotPerformed(event PivotEvent) {
+	if !o.logger.Enabled(context.Background(), LevelTrace) {
+		return
+	}
+	o.logger.Log(context.Background(), LevelTrace, "simplex pivot",
+		"phase", event.Phase,
+		"iteration", event.Iteration,
+		"explanation", event.Explanation,
+		"tableau", event.Tableau.String())
+}
+
+func (o logObserver) SimplexFinished(event SimplexResultEvent) {
+	switch {
+	case event.Unbounded:
+		o.logger.Debug("simplex finished", "result", "unbounded")
+	case !event.HasSolution:
+		o.logger.Debug("simplex finished", "result", "infeasible", "phase", event.Phase)
+	default:
+		o.logger.Debug("simplex finished",
+			"result", "optimal",
+			"value", event.OptimalObjectiveFunctionValue,
+			"values", event.OptimalVariableValues,
+			"tableau", event.Tableau.String())
+	}
+}
+
+func (o logObserver) NodeCreated(event NodeEvent) {
+	o.logger.Debug("node created", "node", event.ID, "parent", event.ParentID, "depth", event.Depth)
+}
+
+func (o logObserver) NodeStarted(event NodeEvent) {
+	o.logger.Debug("node started", "node", event.ID, "depth", event.Depth)
+}
+
+func (o logObserver) NodeBranched(event NodeBranchedEvent) {
+	o.logger.Debug("node branched", "node", event.ID, "value", event.Value, "variable", event.BranchVariable)
+}
+
+func (o logObserver) NodePruned(event NodePrunedEvent) {
+	o.logger.Debug("node pruned", "node", event.ID, "reason", event.Reason)
+}
+
+func (o logObserver) IncumbentFound(event IncumbentEvent) {
+	o.logger.Debug("incumbent found", "value", event.Value, "source", event.Source, "node", event.NodeID)
+}
+
+func (o logObserver) GapChanged(event GapEvent) {
+	o.logger.Debug("gap changed", "incumbent", event.Incumbent, "dual_bound", event.DualBound,
+		"relative_gap", event.RelativeGap, "nodes", event.Nodes)
+}
+
+func (o logObserver) SearchFinished(event SearchResultEvent) {
+	if !event.HasSolution {
+		o.logger.Info("search finished", "status", event.Status, "nodes", event.Nodes)
+		return
+	}
+	o.logger.Info("search finished",
+		"status", event.Status,
+		"value", event.Value,
+		"dual_bound", event.DualBound,
+		"absolute_gap", event.AbsoluteGap,
+		"relative_gap", event.RelativeGap,
+		"nodes", event.Nodes)
+}
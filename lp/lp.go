@@ -1,21 +1,37 @@
 package lp
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
 	"math"
 	"math/big"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type SimplexTableau struct {
+	// Phase is 1 or 2 and Iteration the number of pivots done in the phase, the
+	// first tableau of a phase has iteration 0
 	Phase         int8       `json:"phase"`
 	Iteration     int32      `json:"iteration"`
 	BaseVariables []string   `json:"baseVariables"`
 	Headers       []string   `json:"headers"`
 	Tableau       [][]string `json:"tableau"`
+	// PivotRow and PivotColumn index the Tableau cells of the pivot performed on
+	// this tableau, they are -1 when no pivot follows it
+	PivotRow         int    `json:"pivotRow"`
+	PivotColumn      int    `json:"pivotColumn"`
+	EnteringVariable string `json:"enteringVariable,omitempty"`
+	LeavingVariable  string `json:"leavingVariable,omitempty"`
+	// Ratios holds the ratio test of each constraint row, empty when the row is
+	// not eligible
+	Ratios []string `json:"ratios,omitempty"`
+	// Explanation tells in plain words why the pivot was chosen, or why the
+	// simplex stopped on the last tableau of a phase
+	Explanation string `json:"explanation,omitempty"`
 }
 type LinearProblem struct {
 	ObjectiveFunction             []float64
@@ -33,16 +49,28 @@ type LinearProblem struct {
 	OptimalVariableValues         []float64
 	OptimalObjectiveFunctionValue float64
 	HasSolution                   bool
-	SolutionSteps                 []*SimplexTableau
+	// IsUnbounded is set by Solve when the objective improves without limit
+	IsUnbounded   bool
+	SolutionSteps []*SimplexTableau
+	// skipTableaux is SolveOptions.SkipTableaux of the solve of the tableau
+	skipTableaux bool
+	// Continuous marks the decision variables that the branch and bound does
+	// not need to make integer, a nil slice means every variable is integer
+	Continuous []bool
 }
 
 func valueToFraction(f float64) string {
+	// rounding errors of the pivots would show as huge fractions, and make the
+	// search of simplifyFraction long
+	if rounded := math.Round(f); math.Abs(f-rounded) < tolerance {
+		return fmt.Sprintf("%d", int64(rounded))
+	}
 	r := new(big.Rat)
 	r.SetFloat64(f)
-	if r.IsInt() {
-		return fmt.Sprintf("%d", int64(f))
-	}
 	a, b := simplifyFraction(r.Num().Int64(), r.Denom().Int64(), 1e6)
+	if b == 1 {
+		return fmt.Sprintf("%d", a)
+	}
 	return fmt.Sprintf("%d/%d", a, b)
 }
 func simplifyFraction(numerator, denominator int64, precision float64) (int64, int64) {
@@ -50,6 +78,10 @@ func simplifyFraction(numerator, denominator int64, precision float64) (int64, i
 		for b != 0 {
 			a, b = b, a%b
 		}
+		// a positive divisor keeps the sign on the numerator
+		if a < 0 {
+			return -a
+		}
 		return a
 	}
 
@@ -62,28 +94,25 @@ func simplifyFraction(numerator, denominator int64, precision float64) (int64, i
 		return n, d
 	}
 
-	// Otherwise, find an approximate fraction
+	// Otherwise, take the last convergent of the continued fraction whose
+	// denominator is at most precision, rounding errors only change the terms
+	// after it
 	f := float64(n) / float64(d)
-	var a, b int64 = int64(math.Floor(f)), 1
-	var c, e int64 = int64(math.Ceil(f)), 1
-
-	for math.Abs(float64(b+e)) <= precision {
-		mediant := float64(a+c) / float64(b+e)
-		if f < mediant {
-			c, e = a+c, b+e
-		} else if f > mediant {
-			a, b = a+c, b+e
-		} else {
-			return a + c, b + e
+	var a, b int64 = 1, 0
+	var c, e int64 = 0, 1
+	x := f
+	for {
+		term := math.Floor(x)
+		if term*float64(b)+float64(e) > precision {
+			break
 		}
+		a, b, c, e = int64(term)*a+c, int64(term)*b+e, a, b
+		if x == term {
+			break
+		}
+		x = 1 / (x - term)
 	}
-
-	// Choose the closer approximation
-	if math.Abs(float64(n)/float64(d)-float64(a)/float64(b)) <
-		math.Abs(float64(n)/float64(d)-float64(c)/float64(e)) {
-		return a, b
-	}
-	return c, e
+	return a, b
 }
 func (lp *LinearProblem) CreateSolutionMarkdownExpression() string {
 	var sb strings.Builder
@@ -186,36 +215,74 @@ func (lp *LinearProblem) CreateMarkdownExpression() string {
 	return sb.String()
 }
 func (lp *LinearProblem) Solve() *LinearProblem {
-	fmt.Println("Starting Two-Phased Simplex Algorithm")
+	solution, _ := lp.SolveContext(context.Background(), DefaultSolveOptions())
+	return solution
+}
+
+// SolveContext runs the two-phase simplex algorithm and checks ctx between
+// pivots, it returns the context error when ctx is done before the end
+func (lp *LinearProblem) SolveContext(ctx context.Context, options SolveOptions) (*LinearProblem, error) {
+	start := time.Now()
+	solution, err := lp.solveContext(ctx, options)
+	observeSimplex(lp, solution, err, time.Since(start))
+	return solution, err
+}
 
+// solveContext is SolveContext without the metrics, the node relaxations, the
+// heuristics and the dual problems are solved with it so only the solves asked by
+// the callers of the package are counted
+func (lp *LinearProblem) solveContext(ctx context.Context, options SolveOptions) (*LinearProblem, error) {
+	observer := options.simplexObserver()
 	feasibleSolution := lp.addConstraintVariables()
-	fmt.Println("Initial Tableau for Phase 1:")
-	feasibleSolution.SaveSimplexTableau(0, 0)
-	feasibleSolution.DisplaySimplexTableau()
+	feasibleSolution.skipTableaux = options.SkipTableaux
+	// keep the history of the tableaux on lp as well, even when there is no solution
+	defer func() {
+		lp.SolutionSteps = feasibleSolution.SolutionSteps
+	}()
+	feasibleSolution.SaveSimplexTableau(1, 0)
+	observer.PhaseChanged(PhaseEvent{Phase: 1, Tableau: feasibleSolution.lastSimplexTableau()})
 
 	// Phase 1
-	phase1Solution := feasibleSolution.Phase1()
+	phase1Solution, err := feasibleSolution.phase1(ctx, options)
+	if err != nil {
+		return nil, err
+	}
 	if phase1Solution == nil {
-		fmt.Println("No feasible solution found in Phase 1.")
 		lp.HasSolution = false
-		return nil
+		observer.SimplexFinished(SimplexResultEvent{Phase: 1, Tableau: feasibleSolution.lastSimplexTableau()})
+		return nil, nil
 	}
-	fmt.Println("Phase 1 Complete. Feasible solution found")
 
 	// Phase 2
-	optimalSolution := phase1Solution.Phase2()
+	optimalSolution, err := phase1Solution.phase2(ctx, options)
+	if err != nil {
+		return nil, err
+	}
 	if optimalSolution == nil {
-		fmt.Println("No optimal solution found in Phase 2.")
 		lp.HasSolution = false
-		return nil
+		lp.IsUnbounded = phase1Solution.IsUnbounded
+		observer.SimplexFinished(SimplexResultEvent{
+			Phase:     2,
+			Unbounded: lp.IsUnbounded,
+			Tableau:   phase1Solution.lastSimplexTableau(),
+		})
+		return nil, nil
 	}
-	fmt.Println("Optimal Solution:")
-	optimalSolution.DisplaySimplexTableau()
 	optimalSolution.SaveSolution()
 	optimalSolution.HasSolution = true
-	return optimalSolution
+	observer.SimplexFinished(SimplexResultEvent{
+		HasSolution:                   true,
+		Phase:                         2,
+		Tableau:                       optimalSolution.lastSimplexTableau(),
+		OptimalVariableValues:         optimalSolution.OptimalVariableValues,
+		OptimalObjectiveFunctionValue: optimalSolution.OptimalObjectiveFunctionValue,
+	})
+	return optimalSolution, nil
 }
 
+// SaveSolution reads the values of the variables and Z, the value of the
+// objective, from the optimal tableau into OptimalVariableValues and
+// OptimalObjectiveFunctionValue
 func (lp *LinearProblem) SaveSolution() {
 	for i := 0; i < len(lp.OriginalProblem.ObjectiveFunction); i++ {
 		optimalVariableValue := 0.0
@@ -227,55 +294,96 @@ func (lp *LinearProblem) SaveSolution() {
 			}
 		}
 		lp.OptimalVariableValues = append(lp.OptimalVariableValues, optimalVariableValue)
-		fmt.Printf("x%v=%v\n", i+1, optimalVariableValue)
 	}
-	fmt.Printf("Z=%v\n", lp.Rhs[len(lp.Rhs)-1])
-	lp.OptimalObjectiveFunctionValue = lp.Rhs[len(lp.Rhs)-1]
+	// the rhs of the objective row holds -Z, subtracting it from 0 never gives -0
+	lp.OptimalObjectiveFunctionValue = 0 - lp.Rhs[len(lp.Rhs)-1]
 }
 
+// Phase1 runs the first phase of the simplex on the tableau built by Solve, it
+// returns nil when the problem is infeasible
 func (lp *LinearProblem) Phase1() *LinearProblem {
-	iteration := 0
+	solution, _ := lp.phase1(context.Background(), DefaultSolveOptions())
+	return solution
+}
+
+// Phase2 runs the second phase of the simplex on the solution of Phase1, it
+// returns nil when the problem is unbounded
+func (lp *LinearProblem) Phase2() *LinearProblem {
+	solution, _ := lp.phase2(context.Background(), DefaultSolveOptions())
+	return solution
+}
+
+func (lp *LinearProblem) phase1(ctx context.Context, options SolveOptions) (*LinearProblem, error) {
+	iteration := 1
 	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		pivotColumn := lp.findPivotColumn()
 		if pivotColumn == -1 {
+			lp.saveOptimality(1)
 			// check if the optimal value is 0,
 			// in that case the principal problem have a solution
-			if lp.Rhs[len(lp.Rhs)-1] == 0 {
-				return lp
+			if math.Abs(lp.Rhs[len(lp.Rhs)-1]) < tolerance {
+				return lp, nil
 			}
-			return nil
+			return nil, nil
 		}
 		pivotRow := lp.findPivotRow(pivotColumn)
+		explanation := lp.savePivot(pivotRow, pivotColumn)
 		if pivotRow == -1 {
-			return nil // Unbounded solution
+			return nil, nil // Unbounded solution
 		}
 		lp.BaseVariable[pivotRow] = pivotColumn
 		lp.pivot(pivotRow, pivotColumn)
 		lp.SaveSimplexTableau(1, int32(iteration))
-		lp.DisplaySimplexTableau()
+		observePivot(1)
+		options.simplexObserver().PivotPerformed(PivotEvent{
+			Phase:       1,
+			Iteration:   int32(iteration),
+			PivotRow:    pivotRow,
+			PivotColumn: pivotColumn,
+			Explanation: explanation,
+			Tableau:     lp.lastSimplexTableau(),
+		})
 		iteration++
 	}
 }
 
-func (lp *LinearProblem) Phase2() *LinearProblem {
+func (lp *LinearProblem) phase2(ctx context.Context, options SolveOptions) (*LinearProblem, error) {
 	// Remove artificial variables and reset objective function
-	iteration := 0
+	iteration := 1
 	lp.removeArtificialVariables()
+	lp.SaveSimplexTableau(2, 0)
+	options.simplexObserver().PhaseChanged(PhaseEvent{Phase: 2, Tableau: lp.lastSimplexTableau()})
 	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		pivotColumn := lp.findPivotColumn()
 		if pivotColumn == -1 {
-			return lp // Optimal solution found
+			lp.saveOptimality(2)
+			return lp, nil // Optimal solution found
 		}
 
 		pivotRow := lp.findPivotRow(pivotColumn)
+		explanation := lp.savePivot(pivotRow, pivotColumn)
 		if pivotRow == -1 {
-			log.Fatal("Unbounded solution")
-			return nil // Unbounded solution
+			lp.IsUnbounded = true
+			return nil, nil // Unbounded solution
 		}
 		lp.BaseVariable[pivotRow] = pivotColumn
 		lp.pivot(pivotRow, pivotColumn)
 		lp.SaveSimplexTableau(2, int32(iteration))
-		lp.DisplaySimplexTableau()
+		observePivot(2)
+		options.simplexObserver().PivotPerformed(PivotEvent{
+			Phase:       2,
+			Iteration:   int32(iteration),
+			PivotRow:    pivotRow,
+			PivotColumn: pivotColumn,
+			Explanation: explanation,
+			Tableau:     lp.lastSimplexTableau(),
+		})
 		iteration++
 	}
 }
@@ -283,7 +391,8 @@ func (lp *LinearProblem) Phase2() *LinearProblem {
 func (lp *LinearProblem) findPivotColumn() int {
 	pivotColumn := -1
 	if lp.IsMaximization {
-		minCoeff := 0.0
+		// reduced costs within the tolerance are rounding errors of zero
+		minCoeff := tolerance
 		for j := 0; j < len(lp.ObjectiveFunction); j++ {
 			if lp.ObjectiveFunction[j] > minCoeff {
 				minCoeff = lp.ObjectiveFunction[j]
@@ -291,7 +400,7 @@ func (lp *LinearProblem) findPivotColumn() int {
 			}
 		}
 	} else {
-		maxCoeff := 0.0
+		maxCoeff := -tolerance
 		for j := 0; j < len(lp.ObjectiveFunction); j++ {
 			if lp.ObjectiveFunction[j] < maxCoeff {
 				maxCoeff = lp.ObjectiveFunction[j]
@@ -306,7 +415,7 @@ func (lp *LinearProblem) findPivotRow(pivotColumn int) int {
 	minRatio := math.Inf(1)
 	pivotRow := -1
 	for i := 0; i < lp.InitialConstraintLength; i++ {
-		if lp.Constraints[i][pivotColumn] > 0 {
+		if lp.Constraints[i][pivotColumn] > tolerance {
 			ratio := lp.Rhs[i] / lp.Constraints[i][pivotColumn]
 			if ratio < minRatio {
 				minRatio = ratio
@@ -347,6 +456,7 @@ func (lp *LinearProblem) pivot(pivotRow, pivotColumn int) {
 
 func (lp *LinearProblem) removeArtificialVariables() {
 	newVarCount := lp.InitialObjectiveLength + lp.SurplusVar
+	lp.driveOutArtificialVariables(newVarCount)
 	lp.ObjectiveFunction = lp.ObjectiveFunction[:newVarCount]
 	for i := range lp.Constraints {
 		lp.Constraints[i] = lp.Constraints[i][:newVarCount]
@@ -356,26 +466,86 @@ func (lp *LinearProblem) removeArtificialVariables() {
 	objectiveFunctionRhsValue := 0.0
 	newObjectiveFunction := make([]float64, len(lp.ObjectiveFunction))
 	copy(newObjectiveFunction, lp.OriginalProblem.ObjectiveFunction)
-	// this will maintain the problem as a minimization problem
-	if !lp.OriginalProblem.IsMaximization {
-		for i := range newObjectiveFunction {
-			newObjectiveFunction[i] *= -1
-		}
-	}
 	coeffs := make([]float64, len(lp.ObjectiveFunction))
 	copy(coeffs, lp.OriginalProblem.ObjectiveFunction)
+	// the objective row holds the reduced costs c - z, and its rhs holds -Z
 	for i, constraint := range lp.Constraints {
 		for j, value := range constraint {
-			newObjectiveFunction[j] += value * coeffs[lp.BaseVariable[i]]
+			newObjectiveFunction[j] -= value * coeffs[lp.BaseVariable[i]]
 		}
-		objectiveFunctionRhsValue += lp.Rhs[i] * coeffs[lp.BaseVariable[i]]
+		objectiveFunctionRhsValue -= lp.Rhs[i] * coeffs[lp.BaseVariable[i]]
 	}
 	lp.ObjectiveFunction = newObjectiveFunction
 	lp.Rhs[len(lp.Rhs)-1] = objectiveFunctionRhsValue
 	lp.IsMaximization = lp.OriginalProblem.IsMaximization
 }
 
+// driveOutArtificialVariables pivots every artificial variable that is still
+// basic at zero level after phase 1 out of the base, so that phase 2 only works
+// with the original and surplus variables. Rows in which no such pivot exists
+// are redundant and are dropped.
+func (lp *LinearProblem) driveOutArtificialVariables(newVarCount int) {
+	for i := 0; i < len(lp.BaseVariable); i++ {
+		if lp.BaseVariable[i] < newVarCount {
+			continue
+		}
+		pivotColumn := -1
+		for j := 0; j < newVarCount; j++ {
+			if math.Abs(lp.Constraints[i][j]) > tolerance {
+				pivotColumn = j
+				break
+			}
+		}
+		if pivotColumn != -1 {
+			lp.BaseVariable[i] = pivotColumn
+			lp.pivot(i, pivotColumn)
+			continue
+		}
+		lp.Constraints = append(lp.Constraints[:i], lp.Constraints[i+1:]...)
+		lp.ConstraintTypes = append(lp.ConstraintTypes[:i], lp.ConstraintTypes[i+1:]...)
+		lp.Rhs = append(lp.Rhs[:i], lp.Rhs[i+1:]...)
+		lp.BaseVariable = append(lp.BaseVariable[:i], lp.BaseVariable[i+1:]...)
+		lp.InitialConstraintLength--
+		i--
+	}
+}
+
+// withNonNegativeRhs returns lp, or a copy of lp in which every constraint with
+// a negative rhs is multiplied by -1, turning <= into >= and the other way
+// round, since the simplex starts from the slack and artificial variables
+// holding the rhs
+func (lp *LinearProblem) withNonNegativeRhs() *LinearProblem {
+	normalized := lp
+	for i := range lp.Constraints {
+		if lp.Rhs[i] >= 0 {
+			continue
+		}
+		if normalized == lp {
+			copied := *lp
+			copied.Constraints = append([][]float64(nil), lp.Constraints...)
+			copied.ConstraintTypes = append([]string(nil), lp.ConstraintTypes...)
+			copied.Rhs = append([]float64(nil), lp.Rhs...)
+			normalized = &copied
+		}
+		constraint := make([]float64, len(lp.Constraints[i]))
+		for j, value := range lp.Constraints[i] {
+			constraint[j] = -value
+		}
+		normalized.Constraints[i] = constraint
+		normalized.Rhs[i] = -lp.Rhs[i]
+		switch lp.ConstraintTypes[i] {
+		case "<=":
+			normalized.ConstraintTypes[i] = ">="
+		case ">=":
+			normalized.ConstraintTypes[i] = "<="
+		}
+	}
+	return normalized
+}
+
 func (lp *LinearProblem) addConstraintVariables() *LinearProblem {
+	original := lp
+	lp = lp.withNonNegativeRhs()
 	n := len(lp.ObjectiveFunction)
 	m := len(lp.Constraints)
 	// add the variables
@@ -437,6 +607,10 @@ func (lp *LinearProblem) addConstraintVariables() *LinearProblem {
 		}
 		objectiveFunctionRhsValue += newRhs[i] * coeffs[baseVariables[i]]
 	}
+	// basic artificial variables must have a zero reduced cost
+	for j := range newObjectiveFunction {
+		newObjectiveFunction[j] -= coeffs[j]
+	}
 	// resetting artificial var index to use it in the objective function computation
 	if artificialVars > 0 {
 		newRhs[m] = objectiveFunctionRhsValue
@@ -455,47 +629,79 @@ func (lp *LinearProblem) addConstraintVariables() *LinearProblem {
 		InitialConstraintLength: lp.InitialConstraintLength,
 		InitialObjectiveLength:  lp.InitialObjectiveLength,
 		BaseVariable:            baseVariables,
-		OriginalProblem:         lp,
+		OriginalProblem:         original,
+		Continuous:              lp.Continuous,
 	}
 }
+
+// CreateProblem is ParseProblem for valid problems, it panics when the problem
+// is malformed
 func CreateProblem(problemContent string) *LinearProblem {
-	problemLines := strings.Split(problemContent, "\n")
+	problem, err := ParseProblem(problemContent)
+	if err != nil {
+		panic(err)
+	}
+	return problem
+}
+
+// ParseProblem reads a problem in the text format: the first line is max or min
+// followed by the objective coefficients, each following line is a constraint
+// written as its coefficients, its type and its right hand side
+func ParseProblem(problemContent string) (*LinearProblem, error) {
+	var problemLines [][]string
+	for _, line := range strings.Split(problemContent, "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			problemLines = append(problemLines, fields)
+		}
+	}
 	if len(problemLines) == 0 {
-		log.Fatal("empty file provided")
+		return nil, errors.New("empty problem provided")
 	}
 	objectiveLine := problemLines[0]
 	problemLines = problemLines[1:]
 
-	isMaximization := strings.HasPrefix(objectiveLine, "max")
-	objectiveFunctionValues := strings.Split(objectiveLine, " ")[1:]
+	if objectiveLine[0] != "max" && objectiveLine[0] != "min" {
+		return nil, fmt.Errorf("the objective function must start with max or min, not %q", objectiveLine[0])
+	}
+	isMaximization := objectiveLine[0] == "max"
 	var objectiveFunction []float64
-	for _, value := range objectiveFunctionValues {
+	for _, value := range objectiveLine[1:] {
 		floatValue, err := strconv.ParseFloat(value, 64)
 		if err != nil {
-			log.Fatal("Invalid float value provided in the objective function")
+			return nil, fmt.Errorf("invalid float value %q provided in the objective function", value)
 		}
 		objectiveFunction = append(objectiveFunction, floatValue)
 	}
+	if len(objectiveFunction) == 0 {
+		return nil, errors.New("the objective function has no coefficient")
+	}
 
 	var constraints [][]float64
 	var constraintTypes []string
 	var rhs []float64
-	for i, problemLine := range problemLines {
+	for i, strValues := range problemLines {
+		// coefficients, type and right hand side
+		if len(strValues) != len(objectiveFunction)+2 {
+			return nil, fmt.Errorf("constraint %v must have %v coefficients, a type and a right hand side",
+				i+1, len(objectiveFunction))
+		}
 		var constraintRow []float64
-		strValues := strings.Split(problemLine, " ")
 		for j, value := range strValues {
-			if value == ">=" || value == "<=" || value == "=" {
+			if j == len(objectiveFunction) {
+				if value != ">=" && value != "<=" && value != "=" {
+					return nil, fmt.Errorf("invalid type %q in constraint %v, expected <=, >= or =", value, i+1)
+				}
 				constraintTypes = append(constraintTypes, value)
+				continue
+			}
+			floatValue, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid float value %q provided in constraint %v", value, i+1)
+			}
+			if j == len(strValues)-1 {
+				rhs = append(rhs, floatValue)
 			} else {
-				floatValue, err := strconv.ParseFloat(value, 64)
-				if err != nil {
-					log.Fatalf("Invalid float value provided in the constraint function %v", i)
-				}
-				if j == len(strValues)-1 {
-					rhs = append(rhs, floatValue)
-				} else {
-					constraintRow = append(constraintRow, floatValue)
-				}
+				constraintRow = append(constraintRow, floatValue)
 			}
 		}
 		constraints = append(constraints, constraintRow)
@@ -512,30 +718,80 @@ func CreateProblem(problemContent string) *LinearProblem {
 		SurplusVar:              0,
 		InitialConstraintLength: len(constraints),
 		InitialObjectiveLength:  len(objectiveFunction),
-	}
+	}, nil
+}
 
+// Text writes the problem in the format read by ParseProblem
+func (lp *LinearProblem) Text() string {
+	var sb strings.Builder
+	if lp.IsMaximization {
+		sb.WriteString("max")
+	} else {
+		sb.WriteString("min")
+	}
+	for _, value := range lp.ObjectiveFunction {
+		sb.WriteString(fmt.Sprintf(" %v", value))
+	}
+	for i, constraint := range lp.Constraints {
+		sb.WriteString("\n")
+		for _, value := range constraint {
+			sb.WriteString(fmt.Sprintf("%v ", value))
+		}
+		sb.WriteString(fmt.Sprintf("%s %v", lp.ConstraintTypes[i], lp.Rhs[i]))
+	}
+	return sb.String()
 }
+
+// LoadProblemFromFile is ReadProblemFile for valid files, it panics when the
+// file cannot be read or is malformed
 func LoadProblemFromFile(filename string) *LinearProblem {
+	problem, err := ReadProblemFile(filename)
+	if err != nil {
+		panic(err)
+	}
+	return problem
+}
+
+// ReadProblemFile reads a problem in the text format from a file
+func ReadProblemFile(filename string) (*LinearProblem, error) {
 	file, err := os.ReadFile(filename)
 	if err != nil {
-		log.Fatal("invalid filename provided")
+		return nil, err
 	}
-	content := string(file)
-	return CreateProblem(content)
+	return ParseProblem(string(file))
 }
+
 func (lp *LinearProblem) DisplaySimplexTableau() {
-	lastSimplexTableau := lp.SolutionSteps[len(lp.SolutionSteps)-1]
-	for _, header := range lastSimplexTableau.Headers {
-		fmt.Printf("%-8s	", header)
+	displaySimplexTableau(lp.lastSimplexTableau())
+}
+
+// lastSimplexTableau is nil when the tableaux are not recorded
+func (lp *LinearProblem) lastSimplexTableau() *SimplexTableau {
+	if len(lp.SolutionSteps) == 0 {
+		return nil
 	}
-	fmt.Printf("\n")
-	for i, row := range lastSimplexTableau.Tableau {
-		fmt.Printf("%-8s	", lastSimplexTableau.BaseVariables[i])
+	return lp.SolutionSteps[len(lp.SolutionSteps)-1]
+}
+
+func displaySimplexTableau(simplexTableau *SimplexTableau) {
+	fmt.Print(simplexTableau.String())
+}
+
+// String lays the tableau out as tab separated text, one row per line
+func (simplexTableau *SimplexTableau) String() string {
+	var sb strings.Builder
+	for _, header := range simplexTableau.Headers {
+		sb.WriteString(fmt.Sprintf("%-8s	", header))
+	}
+	sb.WriteString("\n")
+	for i, row := range simplexTableau.Tableau {
+		sb.WriteString(fmt.Sprintf("%-8s	", simplexTableau.BaseVariables[i]))
 		for _, value := range row {
-			fmt.Printf("%-s		", value)
+			sb.WriteString(fmt.Sprintf("%-s		", value))
 		}
-		fmt.Printf("\n")
+		sb.WriteString("\n")
 	}
+	return sb.String()
 }
 
 func (lp *LinearProblem) Clone() *LinearProblem {
@@ -551,6 +807,7 @@ func (lp *LinearProblem) Clone() *LinearProblem {
 		InitialObjectiveLength:  lp.InitialObjectiveLength,
 		BaseVariable:            make([]int, len(lp.BaseVariable)),
 		HasSolution:             lp.HasSolution,
+		Continuous:              lp.Continuous,
 	}
 
 	// Deep copy slice fields
@@ -585,15 +842,21 @@ func (lp *LinearProblem) Clone() *LinearProblem {
 	return clone
 }
 func (lp *LinearProblem) SaveSimplexTableau(phase int8, iteration int32) {
+	if lp.skipTableaux {
+		return
+	}
 	headers := make([]string, len(lp.ObjectiveFunction))
 	tableau := make([][]string, len(lp.Constraints))
+	// the slack and surplus columns follow the decision variables, then the
+	// artificial columns, each of them is numbered from 1
+	firstArtificial := lp.InitialObjectiveLength + lp.SurplusVar
 	for i := 0; i < len(lp.ObjectiveFunction); i++ {
 		if i < lp.InitialObjectiveLength {
 			headers[i] = fmt.Sprintf("x%v", i+1)
-		} else if i >= lp.InitialObjectiveLength && i < lp.InitialObjectiveLength+lp.SurplusVar {
-			headers[i] = fmt.Sprintf("s%v", i+1-(lp.SurplusVar-1))
+		} else if i < firstArtificial {
+			headers[i] = fmt.Sprintf("s%v", i-lp.InitialObjectiveLength+1)
 		} else {
-			headers[i] = fmt.Sprintf("a%v", i+1-(lp.ArtificialVars-1))
+			headers[i] = fmt.Sprintf("a%v", i-firstArtificial+1)
 		}
 	}
 	for i, constraint := range lp.Constraints {
@@ -606,11 +869,7 @@ func (lp *LinearProblem) SaveSimplexTableau(phase int8, iteration int32) {
 	}
 	var baseVariables []string
 	for _, baseIndex := range lp.BaseVariable {
-		if baseIndex < lp.InitialObjectiveLength {
-			baseVariables = append(baseVariables, headers[baseIndex])
-		} else {
-			baseVariables = append(baseVariables, headers[baseIndex])
-		}
+		baseVariables = append(baseVariables, headers[baseIndex])
 	}
 	headers = append([]string{
 		"F",
@@ -631,5 +890,76 @@ func (lp *LinearProblem) SaveSimplexTableau(phase int8, iteration int32) {
 		Tableau:       tableau,
 		Phase:         phase,
 		Iteration:     iteration,
+		PivotRow:      -1,
+		PivotColumn:   -1,
 	})
 }
+
+// savePivot records on the last tableau the pivot about to be performed on it,
+// along with the ratio test of the entering column, and returns its explanation
+func (lp *LinearProblem) savePivot(pivotRow, pivotColumn int) string {
+	simplexTableau := lp.lastSimplexTableau()
+	if simplexTableau == nil {
+		return ""
+	}
+	simplexTableau.PivotRow = pivotRow
+	simplexTableau.PivotColumn = pivotColumn
+	// the first header is the column of the base variables
+	simplexTableau.EnteringVariable = simplexTableau.Headers[pivotColumn+1]
+	if pivotRow != -1 {
+		simplexTableau.LeavingVariable = simplexTableau.BaseVariables[pivotRow]
+	}
+	simplexTableau.Ratios = make([]string, len(lp.Constraints))
+	var ratioTest []string
+	for i := 0; i < lp.InitialConstraintLength; i++ {
+		if lp.Constraints[i][pivotColumn] > tolerance {
+			simplexTableau.Ratios[i] = valueToFraction(lp.Rhs[i] / lp.Constraints[i][pivotColumn])
+			ratioTest = append(ratioTest, fmt.Sprintf("%s/%s=%s",
+				ratioOperand(lp.Rhs[i]), ratioOperand(lp.Constraints[i][pivotColumn]), simplexTableau.Ratios[i]))
+		}
+	}
+	criterion := "most negative"
+	if lp.IsMaximization {
+		criterion = "most positive"
+	}
+	explanation := fmt.Sprintf("%s enters because its reduced cost %s is the %s",
+		simplexTableau.EnteringVariable, valueToFraction(lp.ObjectiveFunction[pivotColumn]), criterion)
+	if pivotRow == -1 {
+		explanation += fmt.Sprintf("; no row has a positive coefficient in the %s column, so the problem is unbounded",
+			simplexTableau.EnteringVariable)
+	} else {
+		explanation += fmt.Sprintf("; ratio test %s picks row %d; %s leaves",
+			strings.Join(ratioTest, " vs "), pivotRow+1, simplexTableau.LeavingVariable)
+	}
+	simplexTableau.Explanation = explanation
+	return explanation
+}
+
+// ratioOperand wraps fractions in parentheses so that a ratio of fractions stays readable
+func ratioOperand(value float64) string {
+	fraction := valueToFraction(value)
+	if strings.Contains(fraction, "/") {
+		return "(" + fraction + ")"
+	}
+	return fraction
+}
+
+// saveOptimality explains on the last tableau why the phase stopped
+func (lp *LinearProblem) saveOptimality(phase int8) {
+	if lp.lastSimplexTableau() == nil {
+		return
+	}
+	sign := "negative"
+	if lp.IsMaximization {
+		sign = "positive"
+	}
+	explanation := fmt.Sprintf("No reduced cost is %s, so the tableau is optimal", sign)
+	if phase == 1 {
+		if math.Abs(lp.Rhs[len(lp.Rhs)-1]) < tolerance {
+			explanation += "; the artificial variables sum to 0, so the problem is feasible"
+		} else {
+			explanation += "; the artificial variables cannot reach 0, so the problem is infeasible"
+		}
+	}
+	lp.lastSimplexTableau().Explanation = explanation
+}
@@ -0,0 +1,141 @@
+package lp
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		problem string
+		value   float64
+		values  []float64
+	}{
+		{"max 5 4\n1 1 <= 5\n10 6 <= 45", 23.75, []float64{3.75, 1.25}},
+		{"min 2 3\n1 1 >= 4\n1 3 >= 6", 9, []float64{3, 1}},
+		{"max 1 1\n1 0 <= 2\n0 1 = 3", 5, []float64{2, 3}},
+		// the right hand sides are negative, the rows are negated before the slack
+		// and artificial variables are added
+		{"max 1 1\n-1 -1 >= -4", 4, []float64{4, 0}},
+		{"min 1 1\n-1 -2 <= -2", 1, []float64{0, 1}},
+	}
+	for _, test := range tests {
+		problem, err := ParseProblem(test.problem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		solution, err := problem.SolveContext(context.Background(), SolveOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if solution == nil {
+			t.Errorf("%q: no solution", test.problem)
+			continue
+		}
+		if math.Abs(solution.OptimalObjectiveFunctionValue-test.value) > tolerance {
+			t.Errorf("%q: Z = %v, want %v", test.problem, solution.OptimalObjectiveFunctionValue, test.value)
+		}
+		if !slices.EqualFunc(solution.OptimalVariableValues, test.values, func(a, b float64) bool {
+			return math.Abs(a-b) <= tolerance
+		}) {
+			t.Errorf("%q: values %v, want %v", test.problem, solution.OptimalVariableValues, test.values)
+		}
+	}
+}
+
+func TestInfeasibleAndUnbounded(t *testing.T) {
+	infeasible, err := ParseProblem("max 1 1\n1 1 <= 1\n1 1 >= 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if solution := infeasible.Solve(); solution != nil {
+		t.Errorf("infeasible problem solved with Z = %v", solution.OptimalObjectiveFunctionValue)
+	}
+	unbounded, err := ParseProblem("max 1 1\n1 -1 <= 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if solution := unbounded.Solve(); solution != nil || !unbounded.IsUnbounded {
+		t.Errorf("unbounded problem solved with %v", solution)
+	}
+}
+
+func TestSkipTableaux(t *testing.T) {
+	problem, err := ParseProblem("max 5 4\n1 1 <= 5\n10 6 <= 45")
+	if err != nil {
+		t.Fatal(err)
+	}
+	solution, err := problem.SolveContext(context.Background(), SolveOptions{SkipTableaux: true})
+	if err != nil || solution == nil {
+		t.Fatalf("solution %v, error %v", solution, err)
+	}
+	if solution.OptimalObjectiveFunctionValue != 23.75 {
+		t.Errorf("Z = %v, want 23.75", solution.OptimalObjectiveFunctionValue)
+	}
+	if len(solution.SolutionSteps) != 0 || len(problem.SolutionSteps) != 0 {
+		t.Errorf("%d tableaux recorded, want none", len(solution.SolutionSteps))
+	}
+}
+
+func TestValueToFraction(t *testing.T) {
+	tests := []struct {
+		value float64
+		want  string
+	}{
+		{7, "7"},
+		{6.999999999999998, "7"},
+		{1e-12, "0"},
+		{-2.5, "-5/2"},
+		{0.1, "1/10"},
+		{2.0 / 7, "2/7"},
+		{1.0/3 + 1e-15, "1/3"},
+		{-1.0 / 3, "-1/3"},
+		{1234.56789, "123456789/100000"},
+	}
+	for _, test := range tests {
+		if got := valueToFraction(test.value); got != test.want {
+			t.Errorf("valueToFraction(%v) = %s, want %s", test.value, got, test.want)
+		}
+	}
+}
+
+func TestSolveCanceled(t *testing.T) {
+	problem, err := ParseProblem("max 5 4\n1 1 <= 5\n10 6 <= 45")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := problem.SolveContext(ctx, SolveOptions{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+}
+
+func TestParseProblemErrors(t *testing.T) {
+	for _, content := range []string{"", "maximize 1 2", "max 1 x", "max 1 2\n1 1 <", "max 1 2\n1 1 < 3"} {
+		if _, err := ParseProblem(content); err == nil {
+			t.Errorf("ParseProblem(%q) succeeded, want an error", content)
+		}
+	}
+	if _, err := ReadProblemFile("missing.txt"); err == nil {
+		t.Error("ReadProblemFile(missing.txt) succeeded, want an error")
+	}
+}
+
+func TestCreateProblem(t *testing.T) {
+	if problem := CreateProblem("max 5 4\n1 1 <= 5\n10 6 <= 45"); len(problem.Constraints) != 2 {
+		t.Errorf("CreateProblem() has %d constraints, want 2", len(problem.Constraints))
+	}
+	if problem := LoadIntegerLinearProblemFromFile("../file.txt"); len(problem.InitialProblem.ObjectiveFunction) == 0 {
+		t.Error("LoadIntegerLinearProblemFromFile() read no objective")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("CreateProblem() of a malformed problem did not panic")
+		}
+	}()
+	CreateProblem("max 1 x")
+}
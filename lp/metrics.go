@@ -0,0 +1,48 @@
+package lp
+
+import (
+	"pnle/metrics"
+	"strconv"
+	"time"
+)
+
+var (
+	simplexSolves = metrics.Default.NewCounter("pnle_simplex_solves_total",
+		"Linear problems solved by the simplex, by result, without the relaxations solved by branch and bound.", "result")
+	simplexDuration = metrics.Default.NewHistogram("pnle_simplex_solve_duration_seconds",
+		"Time spent solving a linear problem with the simplex.", metrics.ExponentialBuckets(0.00001, 4, 10))
+	simplexPivots = metrics.Default.NewCounter("pnle_simplex_pivots_total",
+		"Simplex pivots, by phase, including the relaxations solved by branch and bound.", "phase")
+	searchSolves = metrics.Default.NewCounter("pnle_ilp_solves_total",
+		"Integer problems solved by branch and bound, by status.", "status")
+	searchDuration = metrics.Default.NewHistogram("pnle_ilp_solve_duration_seconds",
+		"Time spent solving an integer problem with branch and bound.", metrics.ExponentialBuckets(0.0001, 4, 10))
+	searchNodes = metrics.Default.NewHistogram("pnle_ilp_nodes",
+		"Branch and bound nodes processed per solve.", metrics.ExponentialBuckets(1, 4, 9))
+)
+
+// observeSimplex records a solve of lp that returned solution and err
+func observeSimplex(lp *LinearProblem, solution *LinearProblem, err error, elapsed time.Duration) {
+	result := "infeasible"
+	switch {
+	case err != nil:
+		result = "canceled"
+	case solution != nil:
+		result = "optimal"
+	case lp.IsUnbounded:
+		result = "unbounded"
+	}
+	simplexSolves.Inc(result)
+	simplexDuration.Observe(elapsed.Seconds())
+}
+
+func observePivot(phase int8) {
+	simplexPivots.Inc(strconv.Itoa(int(phase)))
+}
+
+// observeSearch records a solve of ilp, once its result is saved
+func observeSearch(ilp *IntegerLineaProblem, elapsed time.Duration) {
+	searchSolves.Inc(string(ilp.Status))
+	searchDuration.Observe(elapsed.Seconds())
+	searchNodes.Observe(float64(ilp.Nodes))
+}
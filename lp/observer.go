@@ -0,0 +1,269 @@
+package lp
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SolverObserver receives the events of the simplex and branch and bound solvers.
+// Node relaxations are solved on several goroutines, so the methods must be safe
+// for concurrent use
+type SolverObserver interface {
+	// PhaseChanged is called when a simplex phase starts, with its initial tableau
+	PhaseChanged(event PhaseEvent)
+	PivotPerformed(event PivotEvent)
+	// SimplexFinished is called once per solved linear problem
+	SimplexFinished(event SimplexResultEvent)
+	NodeCreated(event NodeEvent)
+	// NodeStarted is called when a worker starts solving the relaxation of a node
+	NodeStarted(event NodeEvent)
+	NodeBranched(event NodeBranchedEvent)
+	NodePruned(event NodePrunedEvent)
+	IncumbentFound(event IncumbentEvent)
+	// GapChanged is called when the dual bound or the incumbent changes, once an
+	// incumbent is found
+	GapChanged(event GapEvent)
+	SearchFinished(event SearchResultEvent)
+}
+
+type PhaseEvent struct {
+	Phase   int8
+	Tableau *SimplexTableau
+}
+
+type PivotEvent struct {
+	Phase       int8
+	Iteration   int32
+	PivotRow    int
+	PivotColumn int
+	// Explanation tells why the pivot was chosen, Tableau is the one after the pivot
+	Explanation string
+	Tableau     *SimplexTableau
+}
+
+type SimplexResultEvent struct {
+	HasSolution bool
+	// Phase is the phase in which the simplex stopped
+	Phase int8
+	// Unbounded tells that the simplex stopped because the objective is unbounded
+	Unbounded                     bool
+	Tableau                       *SimplexTableau
+	OptimalVariableValues         []float64
+	OptimalObjectiveFunctionValue float64
+}
+
+type NodeEvent struct {
+	ID       int
+	ParentID int
+	Depth    int
+	// BranchVariable is the index of the variable bounded to create the node, -1 for the root
+	BranchVariable int
+	// BranchDirection is "<=" or ">=", the type of the bound constraint
+	BranchDirection string
+	BranchBound     float64
+	// Bound is the best objective value a solution of the node could reach
+	Bound float64
+}
+
+type NodeBranchedEvent struct {
+	ID             int
+	Value          float64
+	BranchVariable int
+}
+
+// PruneReason tells why a node was not branched on
+type PruneReason string
+
+const (
+	PrunedByBound       PruneReason = "bound"
+	PrunedInfeasible    PruneReason = "infeasible"
+	PrunedByIntegrality PruneReason = "integral"
+	PrunedUnbounded     PruneReason = "unbounded"
+)
+
+type NodePrunedEvent struct {
+	ID     int
+	Reason PruneReason
+	// Value is the relaxation value of the node, it is not set for an infeasible
+	// or unbounded node
+	Value float64
+}
+
+type IncumbentEvent struct {
+	Value  float64
+	Values []float64
+	// Source is "branch and bound" or the name of the heuristic that found the incumbent
+	Source string
+	// NodeID is the node on which the incumbent was found
+	NodeID int
+}
+
+type GapEvent struct {
+	Incumbent   float64
+	DualBound   float64
+	AbsoluteGap float64
+	RelativeGap float64
+	// Nodes is the number of processed nodes
+	Nodes   int
+	Elapsed time.Duration
+}
+
+type SearchResultEvent struct {
+	Status      SolveStatus
+	HasSolution bool
+	Value       float64
+	DualBound   float64
+	AbsoluteGap float64
+	RelativeGap float64
+	Nodes       int
+}
+
+// BaseObserver ignores every event, embed it to implement only some methods
+type BaseObserver struct{}
+
+func (BaseObserver) PhaseChanged(PhaseEvent)            {}
+func (BaseObserver) PivotPerformed(PivotEvent)          {}
+func (BaseObserver) SimplexFinished(SimplexResultEvent) {}
+func (BaseObserver) NodeCreated(NodeEvent)              {}
+func (BaseObserver) NodeStarted(NodeEvent)              {}
+func (BaseObserver) NodeBranched(NodeBranchedEvent)     {}
+func (BaseObserver) NodePruned(NodePrunedEvent)         {}
+func (BaseObserver) IncumbentFound(IncumbentEvent)      {}
+func (BaseObserver) GapChanged(GapEvent)                {}
+func (BaseObserver) SearchFinished(SearchResultEvent)   {}
+
+// observerList forwards every event to each of its observers
+type observerList []SolverObserver
+
+func (l observerList) PhaseChanged(event PhaseEvent) {
+	for _, observer := range l {
+		observer.PhaseChanged(event)
+	}
+}
+
+func (l observerList) PivotPerformed(event PivotEvent) {
+	for _, observer := range l {
+		observer.PivotPerformed(event)
+	}
+}
+
+func (l observerList) SimplexFinished(event SimplexResultEvent) {
+	for _, observer := range l {
+		observer.SimplexFinished(event)
+	}
+}
+
+func (l observerList) NodeCreated(event NodeEvent) {
+	for _, observer := range l {
+		observer.NodeCreated(event)
+	}
+}
+
+func (l observerList) NodeStarted(event NodeEvent) {
+	for _, observer := range l {
+		observer.NodeStarted(event)
+	}
+}
+
+func (l observerList) NodeBranched(event NodeBranchedEvent) {
+	for _, observer := range l {
+		observer.NodeBranched(event)
+	}
+}
+
+func (l observerList) NodePruned(event NodePrunedEvent) {
+	for _, observer := range l {
+		observer.NodePruned(event)
+	}
+}
+
+func (l observerList) IncumbentFound(event IncumbentEvent) {
+	for _, observer := range l {
+		observer.IncumbentFound(event)
+	}
+}
+
+func (l observerList) GapChanged(event GapEvent) {
+	for _, observer := range l {
+		observer.GapChanged(event)
+	}
+}
+
+func (l observerList) SearchFinished(event SearchResultEvent) {
+	for _, observer := range l {
+		observer.SearchFinished(event)
+	}
+}
+
+// ConsoleObserver prints the progress of the solvers to the standard output, it
+// can be added to SolveOptions.Observers
+type ConsoleObserver struct {
+	BaseObserver
+}
+
+// consoleMu keeps the lines and tableaux printed by concurrent node
+// relaxations apart
+var consoleMu sync.Mutex
+
+func (ConsoleObserver) PhaseChanged(event PhaseEvent) {
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+	if event.Phase == 1 {
+		fmt.Println("Starting Two-Phased Simplex Algorithm")
+		fmt.Println("Initial Tableau for Phase 1:")
+		displaySimplexTableau(event.Tableau)
+	} else {
+		fmt.Println("Phase 1 Complete. Feasible solution found")
+	}
+}
+
+func (ConsoleObserver) PivotPerformed(event PivotEvent) {
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+	displaySimplexTableau(event.Tableau)
+}
+
+func (ConsoleObserver) SimplexFinished(event SimplexResultEvent) {
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+	if !event.HasSolution {
+		fmt.Printf("No feasible solution found in Phase %v.\n", event.Phase)
+		return
+	}
+	fmt.Println("Optimal Solution:")
+	displaySimplexTableau(event.Tableau)
+	for i, value := range event.OptimalVariableValues {
+		fmt.Printf("x%v=%v\n", i+1, value)
+	}
+	fmt.Printf("Z=%v\n", event.OptimalObjectiveFunctionValue)
+}
+
+func (ConsoleObserver) NodeStarted(event NodeEvent) {
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+	fmt.Printf("Node %v (depth %v)\n", event.ID, event.Depth)
+}
+
+func (ConsoleObserver) NodePruned(event NodePrunedEvent) {
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+	fmt.Printf("Node %v pruned: %s\n", event.ID, event.Reason)
+}
+
+func (ConsoleObserver) IncumbentFound(event IncumbentEvent) {
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+	fmt.Printf("New incumbent Z=%v found by %s\n", event.Value, event.Source)
+}
+
+func (ConsoleObserver) SearchFinished(event SearchResultEvent) {
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+	if !event.HasSolution {
+		fmt.Printf("Status: %s\n", event.Status)
+		return
+	}
+	fmt.Printf("Status: %s, Z=%v, dual bound=%v, gap=%v (%.4f%%)\n",
+		event.Status, event.Value, event.DualBound, event.AbsoluteGap, event.RelativeGap*100)
+}
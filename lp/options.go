@@ -0,0 +1,78 @@
+package lp
+
+import (
+	"log/slog"
+	"runtime"
+	"time"
+)
+
+// SolveOptions configures the solvers, LinearProblem only uses SkipTableaux, the
+// observers and the logger
+type SolveOptions struct {
+	// Workers is the number of goroutines solving node relaxations concurrently
+	Workers int
+	// Deterministic processes the open nodes in synchronized rounds, so for a
+	// given number of workers the explored tree is the same no matter how the
+	// goroutines are scheduled
+	Deterministic bool
+	// Heuristics are the primal heuristics run at the root node and, every
+	// HeuristicFrequency nodes, in the tree
+	Heuristics []PrimalHeuristic
+	// HeuristicFrequency is the number of nodes between two heuristic runs in the
+	// tree, zero runs the heuristics at the root node only
+	HeuristicFrequency int
+	// RelativeGapTolerance stops the search once the relative gap between the
+	// incumbent and the dual bound is at most this value
+	RelativeGapTolerance float64
+	// NodeLimit stops the search after that many nodes, zero means no limit
+	NodeLimit int
+	// TimeLimit stops the search after that much wall-clock time, zero means no limit
+	TimeLimit time.Duration
+	// SolutionLimit stops the search after that many incumbents, zero means no limit
+	SolutionLimit int
+	// SkipTableaux solves the linear problems without recording their tableaux in
+	// SolutionSteps, nor reporting the simplex events, which carry them. It is set
+	// for the linear problems of the heuristics and the dual problems
+	SkipTableaux bool
+	// Observers receive the events of the solvers
+	Observers []SolverObserver
+	// Logger logs the progress of the solvers, nil keeps them silent
+	Logger *slog.Logger
+}
+
+// DefaultSolveOptions returns the options used by IntegerLineaProblem.Solve
+func DefaultSolveOptions() SolveOptions {
+	return SolveOptions{
+		Workers:              runtime.NumCPU(),
+		Heuristics:           DefaultHeuristics(),
+		HeuristicFrequency:   10,
+		RelativeGapTolerance: 1e-6,
+	}
+}
+
+func (options SolveOptions) workers() int {
+	if options.Workers < 1 {
+		return 1
+	}
+	return options.Workers
+}
+
+// observers are the observers of options, with the logger when there is one
+func (options SolveOptions) observers() []SolverObserver {
+	if options.Logger == nil {
+		return options.Observers
+	}
+	return append(options.Observers[:len(options.Observers):len(options.Observers)], logObserver{options.Logger})
+}
+
+func (options SolveOptions) observer() SolverObserver {
+	return observerList(options.observers())
+}
+
+// simplexObserver receives the simplex events, there is none without tableaux
+func (options SolveOptions) simplexObserver() SolverObserver {
+	if options.SkipTableaux {
+		return observerList(nil)
+	}
+	return options.observer()
+}
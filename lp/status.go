@@ -0,0 +1,23 @@
+package lp
+
+// SolveStatus tells why IntegerLineaProblem.Solve stopped
+type SolveStatus string
+
+const (
+	// StatusOptimal means the incumbent is proven optimal
+	StatusOptimal SolveStatus = "optimal"
+	// StatusInfeasible means the problem has no integer solution
+	StatusInfeasible SolveStatus = "infeasible"
+	// StatusUnbounded means the relaxation of the problem is unbounded
+	StatusUnbounded SolveStatus = "unbounded"
+	// StatusGapLimit means the relative gap fell below SolveOptions.RelativeGapTolerance
+	StatusGapLimit SolveStatus = "gap_limit"
+	// StatusNodeLimit means SolveOptions.NodeLimit nodes were explored
+	StatusNodeLimit SolveStatus = "node_limit"
+	// StatusTimeLimit means the search ran for SolveOptions.TimeLimit
+	StatusTimeLimit SolveStatus = "time_limit"
+	// StatusSolutionLimit means SolveOptions.SolutionLimit incumbents were found
+	StatusSolutionLimit SolveStatus = "solution_limit"
+	// StatusCanceled means the context given to SolveContext was done
+	StatusCanceled SolveStatus = "canceled"
+)
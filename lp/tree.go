@@ -0,0 +1,220 @@
+package lp
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+)
+
+// NodeStatus is the outcome of a branch and bound node
+type NodeStatus string
+
+const (
+	// NodeOpen means the node was not solved before the search stopped
+	NodeOpen          NodeStatus = "open"
+	NodeBranched      NodeStatus = "branched"
+	NodePrunedByBound NodeStatus = "pruned_by_bound"
+	NodeInfeasible    NodeStatus = "infeasible"
+	NodeIntegral      NodeStatus = "integral"
+	NodeUnbounded     NodeStatus = "unbounded"
+)
+
+type TreeNode struct {
+	ID int `json:"id"`
+	// ParentID is 0 for the root
+	ParentID int `json:"parentId"`
+	Depth    int `json:"depth"`
+	// BranchVariable is the index of the variable bounded to create the node, -1 for the root
+	BranchVariable  int     `json:"branchVariable"`
+	BranchDirection string  `json:"branchDirection,omitempty"`
+	BranchBound     float64 `json:"branchBound"`
+	// Bound is the relaxation value of the parent, it is not set for the root
+	Bound *float64 `json:"bound,omitempty"`
+	// Value is the relaxation value of the node, it is not set when the node is
+	// infeasible or was not solved
+	Value  *float64   `json:"value,omitempty"`
+	Status NodeStatus `json:"status"`
+	// Incumbent tells whether an incumbent was found while processing the node
+	Incumbent bool `json:"incumbent"`
+}
+
+// BranchAndBoundTree records every node explored by IntegerLineaProblem.Solve,
+// it is a SolverObserver so it can also be registered in SolveOptions
+type BranchAndBoundTree struct {
+	BaseObserver
+	mu    sync.Mutex
+	Nodes []*TreeNode `json:"nodes"`
+	byID  map[int]*TreeNode
+}
+
+func NewBranchAndBoundTree() *BranchAndBoundTree {
+	return &BranchAndBoundTree{byID: make(map[int]*TreeNode)}
+}
+
+func (t *BranchAndBoundTree) NodeCreated(event NodeEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	node := &TreeNode{
+		ID:              event.ID,
+		ParentID:        event.ParentID,
+		Depth:           event.Depth,
+		BranchVariable:  event.BranchVariable,
+		BranchDirection: event.BranchDirection,
+		BranchBound:     event.BranchBound,
+		Status:          NodeOpen,
+	}
+	if !math.IsInf(event.Bound, 0) {
+		bound := event.Bound
+		node.Bound = &bound
+	}
+	t.Nodes = append(t.Nodes, node)
+	t.byID[node.ID] = node
+}
+
+func (t *BranchAndBoundTree) NodeBranched(event NodeBranchedEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if node, ok := t.byID[event.ID]; ok {
+		value := event.Value
+		node.Value = &value
+		node.Status = NodeBranched
+	}
+}
+
+func (t *BranchAndBoundTree) NodePruned(event NodePrunedEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	node, ok := t.byID[event.ID]
+	if !ok {
+		return
+	}
+	switch event.Reason {
+	case PrunedInfeasible:
+		node.Status = NodeInfeasible
+		return
+	case PrunedUnbounded:
+		node.Status = NodeUnbounded
+		return
+	case PrunedByBound:
+		node.Status = NodePrunedByBound
+	case PrunedByIntegrality:
+		node.Status = NodeIntegral
+	}
+	value := event.Value
+	node.Value = &value
+}
+
+func (t *BranchAndBoundTree) IncumbentFound(event IncumbentEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if node, ok := t.byID[event.NodeID]; ok {
+		node.Incumbent = true
+	}
+}
+
+// JSON returns the nodes of the tree ordered by ID
+func (t *BranchAndBoundTree) JSON() ([]byte, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return json.MarshalIndent(t, "", "  ")
+}
+
+// DOT returns the tree in the Graphviz DOT language
+func (t *BranchAndBoundTree) DOT() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var sb strings.Builder
+	sb.WriteString("digraph BranchAndBound {\n")
+	sb.WriteString("\tnode [shape=box, style=\"rounded,filled\", fontname=\"Arial\"];\n")
+	for _, node := range t.Nodes {
+		sb.WriteString(fmt.Sprintf("\tn%d [label=\"%s\", fillcolor=\"%s\"", node.ID, node.label("\\n"), node.Status.color()))
+		if node.Incumbent {
+			sb.WriteString(", penwidth=3")
+		}
+		sb.WriteString("];\n")
+	}
+	for _, node := range t.Nodes {
+		if node.ParentID == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\tn%d -> n%d [label=\"x%d %s %v\"];\n",
+			node.ParentID, node.ID, node.BranchVariable+1, node.BranchDirection, node.BranchBound))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// TikZ returns the tree as a TikZ picture, the children of a node are ordered
+// by ID and their edges are labeled with the bound of the branch
+func (t *BranchAndBoundTree) TikZ() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	children := make(map[int][]*TreeNode)
+	for _, node := range t.Nodes {
+		children[node.ParentID] = append(children[node.ParentID], node)
+	}
+	var sb strings.Builder
+	sb.WriteString("\\begin{tikzpicture}[\n")
+	sb.WriteString("\tlevel distance=22mm,\n")
+	// the subtrees of a binary tree get half of the width at each level
+	sb.WriteString("\tlevel/.style={sibling distance=64mm/#1},\n")
+	sb.WriteString("\tevery node/.style={draw, rounded corners, align=center, font=\\small},\n")
+	sb.WriteString("\tedge from parent/.style={draw, ->}]\n")
+	for _, root := range children[0] {
+		sb.WriteString("\\node")
+		writeTikZNode(&sb, root, children, "\t")
+		sb.WriteString(";\n")
+	}
+	sb.WriteString("\\end{tikzpicture}\n")
+	return sb.String()
+}
+
+func writeTikZNode(sb *strings.Builder, node *TreeNode, children map[int][]*TreeNode, indent string) {
+	style := "fill=" + node.Status.tikzColor()
+	if node.Incumbent {
+		style += ", line width=1.5pt"
+	}
+	sb.WriteString(fmt.Sprintf("[%s] {%s}", style, node.label("\\\\")))
+	for _, child := range children[node.ID] {
+		comparison := "\\leq"
+		if child.BranchDirection == ">=" {
+			comparison = "\\geq"
+		}
+		sb.WriteString(fmt.Sprintf("\n%schild { node", indent))
+		writeTikZNode(sb, child, children, indent+"\t")
+		sb.WriteString(fmt.Sprintf("\n%s\tedge from parent node[draw=none, fill=white, midway] {$x_{%d} %s %s$} }",
+			indent, child.BranchVariable+1, comparison, latexValue(valueToFraction(child.BranchBound))))
+	}
+}
+
+func (node *TreeNode) label(separator string) string {
+	lines := []string{fmt.Sprintf("Node %d", node.ID)}
+	if node.Value != nil {
+		lines = append(lines, fmt.Sprintf("Z = %s", valueToFraction(*node.Value)))
+	}
+	lines = append(lines, strings.ReplaceAll(string(node.Status), "_", " "))
+	return strings.Join(lines, separator)
+}
+
+func (status NodeStatus) color() string {
+	switch status {
+	case NodeBranched:
+		return "#d6eaf8"
+	case NodePrunedByBound:
+		return "#fcf3cf"
+	case NodeInfeasible, NodeUnbounded:
+		return "#f5b7b1"
+	case NodeIntegral:
+		return "#abebc6"
+	}
+	return "#ffffff"
+}
+
+// tikzColor is color as an xcolor expression
+func (status NodeStatus) tikzColor() string {
+	var r, g, b int
+	fmt.Sscanf(status.color(), "#%02x%02x%02x", &r, &g, &b)
+	return fmt.Sprintf("{rgb,255:red,%d;green,%d;blue,%d}", r, g, b)
+}
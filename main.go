@@ -1,39 +1,48 @@
 package main
 
 import (
-	"log"
-	"pnle/lp"
-
-	"github.com/gin-gonic/gin"
+	"fmt"
+	"os"
 )
 
-func main() {
+const usage = `Usage: pnle <command> [flags]
+
+Commands:
+  serve    start the web server (default when no command is given)
+  solve    solve a problem file and print the solution
+  batch    solve many problem files concurrently and print a report
+  convert  write a problem file in another format
+
+Run "pnle <command> -h" for the flags of a command.
 
-	r := gin.Default()
-	r.Static("/assets", "./static")
-	r.GET("/", func(ctx *gin.Context) {
-		ctx.File("./static/index.html")
-	})
-	r.POST("/solve", func(ctx *gin.Context) {
-		var requestBody struct {
-			ProblemString string `json:"problemString" binding:"required"`
-		}
-		if err := ctx.Copy().ShouldBindJSON(&requestBody); err != nil {
-			ctx.JSON(400, gin.H{
-				"error": "Required parameter problemString not found",
-			})
-			return
-		}
-		problem := lp.CreateIntegerLinearProblem(requestBody.ProblemString)
-		solution := problem.Solve()
-		ctx.JSON(200, gin.H{
-			"solutionProblemString": solution.CreateMarkdownExpression(),
-			"tableaux":              solution.SolutionSteps,
-			"solutionString":        solution.CreateSolutionMarkdownExpression(),
-		})
-	})
-	err := r.Run()
-	if err != nil {
-		log.Fatal(err)
+Exit codes of solve:
+  0  a solution was found
+  1  invalid arguments or input
+  2  the problem is infeasible
+  3  the problem is unbounded
+  4  the search stopped on a limit before finding a solution
+
+batch exits with 1 when a problem file cannot be read.
+`
+
+func main() {
+	args := os.Args[1:]
+	if len(args) == 0 {
+		os.Exit(serve(nil))
+	}
+	switch args[0] {
+	case "serve":
+		os.Exit(serve(args[1:]))
+	case "solve":
+		os.Exit(solve(args[1:]))
+	case "batch":
+		os.Exit(runBatch(args[1:]))
+	case "convert":
+		os.Exit(convert(args[1:]))
+	case "help", "-h", "-help", "--help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", args[0], usage)
+		os.Exit(exitError)
 	}
 }
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"pnle/jobs"
+	"pnle/metrics"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+)
+
+var parseErrors = metrics.Default.NewCounter("pnle_parse_errors_total",
+	"Requests refused because their problem could not be read, by format.", "format")
+
+// ready tells whether the server accepts requests, it is false before the server
+// listens and once it is shutting down
+var ready atomic.Bool
+
+// registerJobMetrics exposes the number of jobs of jobManager
+func registerJobMetrics(jobManager *jobs.Manager) {
+	metrics.Default.NewGaugeFunc("pnle_jobs_queued", "Jobs waiting for a worker.", func() float64 {
+		queued, _ := jobManager.Counts()
+		return float64(queued)
+	})
+	metrics.Default.NewGaugeFunc("pnle_jobs_running", "Jobs being solved.", func() float64 {
+		_, running := jobManager.Counts()
+		return float64(running)
+	})
+}
+
+// healthz answers as long as the process serves requests
+func healthz(ctx *gin.Context) {
+	ctx.JSON(200, gin.H{"status": "ok"})
+}
+
+// readyz answers 503 while the server is not ready to take new solves
+func readyz(ctx *gin.Context) {
+	if !ready.Load() {
+		ctx.JSON(503, gin.H{"status": "not ready"})
+		return
+	}
+	ctx.JSON(200, gin.H{"status": "ready"})
+}
+
+// metricsText writes the metrics in the Prometheus text format
+func metricsText(ctx *gin.Context) {
+	ctx.Header("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	ctx.Status(200)
+	if err := metrics.Default.WriteText(ctx.Writer); err != nil {
+		ctx.Error(err)
+	}
+}
@@ -0,0 +1,356 @@
+package modelfile
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"pnle/api"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// lpToken matches the tokens of the LP format: numbers, comparison operators,
+// signs, colons and names, which cannot start with a digit or a period
+var lpToken = regexp.MustCompile(`(?i)^(?:` +
+	`(?P<number>(?:\d+\.?\d*|\.\d+)(?:e[+-]?\d+)?|inf(?:inity)?\b)|` +
+	`(?P<comparison><=|=<|>=|=>|<|>|=)|` +
+	`(?P<sign>[+-])|` +
+	`(?P<colon>:)|` +
+	`(?P<name>[a-z_!"#$%&()/,;?@'{}|~][^\s+\-*^<>=:\\]*))`)
+
+type lpTokenKind int
+
+const (
+	lpNumber lpTokenKind = iota
+	lpComparison
+	lpSign
+	lpColon
+	lpName
+)
+
+type token struct {
+	kind lpTokenKind
+	text string
+	line int
+}
+
+// lpSection matches the keywords starting the sections of the LP format
+var lpSection = regexp.MustCompile(`(?i)^\s*(maximi[sz]e|maximum|max|minimi[sz]e|minimum|min|subject\s+to|such\s+that|s\.t\.|st|bounds?|generals?|gen|integers?|binar(?:y|ies)|bin|end)(?:\s|$)`)
+
+// ParseLP reads a problem in the CPLEX LP format: an objective section, the
+// constraints after "subject to", then the optional bounds, generals and
+// binaries sections. The variables are continuous unless they are listed in
+// the generals or binaries, and they must be non negative
+func ParseLP(content []byte) (*api.Problem, error) {
+	sections := make(map[string][]token)
+	var order []string
+	section := ""
+	for i, line := range strings.Split(string(content), "\n") {
+		// a backslash starts a comment
+		if comment := strings.IndexByte(line, '\\'); comment >= 0 {
+			line = line[:comment]
+		}
+		if match := lpSection.FindStringSubmatch(line); match != nil {
+			name := lpSectionName(match[1])
+			if name == "end" {
+				break
+			}
+			if _, ok := sections[name]; ok {
+				return nil, fmt.Errorf("line %d: the %s section is repeated", i+1, name)
+			}
+			section = name
+			sections[name] = nil
+			order = append(order, name)
+			line = line[len(match[0]):]
+		}
+		tokens, err := tokenizeLP(line, i+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(tokens) > 0 && section == "" {
+			return nil, fmt.Errorf("line %d: the file must start with maximize or minimize", i+1)
+		}
+		sections[section] = append(sections[section], tokens...)
+	}
+	if len(order) == 0 || (order[0] != "max" && order[0] != "min") {
+		return nil, errors.New("the file must start with maximize or minimize")
+	}
+
+	m := newModel()
+	m.problem.Objective.Sense = order[0]
+	if err := readLPObjective(m, sections[order[0]]); err != nil {
+		return nil, err
+	}
+	if err := readLPConstraints(m, sections["constraints"]); err != nil {
+		return nil, err
+	}
+	if err := readLPBounds(m, sections["bounds"]); err != nil {
+		return nil, err
+	}
+	for _, t := range sections["generals"] {
+		if t.kind != lpName {
+			return nil, fmt.Errorf("line %d: expected a variable name in the generals, got %q", t.line, t.text)
+		}
+		m.variable(t.text).Type = api.VariableInteger
+	}
+	for _, t := range sections["binaries"] {
+		if t.kind != lpName {
+			return nil, fmt.Errorf("line %d: expected a variable name in the binaries, got %q", t.line, t.text)
+		}
+		m.variable(t.text).Type = api.VariableBinary
+	}
+	return m.problem, nil
+}
+
+func lpSectionName(keyword string) string {
+	keyword = strings.ToLower(strings.Join(strings.Fields(keyword), " "))
+	switch {
+	case strings.HasPrefix(keyword, "max"):
+		return "max"
+	case strings.HasPrefix(keyword, "min"):
+		return "min"
+	case strings.HasPrefix(keyword, "bound"):
+		return "bounds"
+	case strings.HasPrefix(keyword, "gen"), strings.HasPrefix(keyword, "integer"):
+		return "generals"
+	case strings.HasPrefix(keyword, "bin"):
+		return "binaries"
+	case keyword == "end":
+		return "end"
+	}
+	return "constraints"
+}
+
+func tokenizeLP(line string, number int) ([]token, error) {
+	var tokens []token
+	for line = strings.TrimSpace(line); line != ""; line = strings.TrimSpace(line) {
+		match := lpToken.FindStringSubmatchIndex(line)
+		if match == nil {
+			return nil, fmt.Errorf("line %d: unexpected %q", number, line)
+		}
+		for kind := lpNumber; kind <= lpName; kind++ {
+			// the groups are in the order of the kinds, after the whole match
+			if start := match[2*int(kind)+2]; start >= 0 {
+				tokens = append(tokens, token{kind: kind, text: line[start:match[2*int(kind)+3]], line: number})
+				break
+			}
+		}
+		line = line[match[1]:]
+	}
+	return tokens, nil
+}
+
+// readLPExpression reads a linear expression from the start of tokens into
+// coefficients, adding its variables to m, it returns the tokens that follow it
+func readLPExpression(m *model, tokens []token, coefficients map[string]float64) ([]token, error) {
+	for len(tokens) > 0 && tokens[0].kind != lpComparison {
+		coefficient := 1.0
+		if tokens[0].kind == lpSign {
+			if tokens[0].text == "-" {
+				coefficient = -1
+			}
+			tokens = tokens[1:]
+		} else if len(coefficients) > 0 {
+			return tokens, nil
+		}
+		if len(tokens) > 0 && tokens[0].kind == lpNumber {
+			value, err := lpNumberValue(tokens[0])
+			if err != nil {
+				return nil, err
+			}
+			coefficient *= value
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 || tokens[0].kind != lpName {
+			if len(tokens) == 0 || tokens[0].kind == lpComparison || tokens[0].kind == lpSign {
+				return nil, errors.New("constant terms in expressions are not supported")
+			}
+			return nil, fmt.Errorf("line %d: expected a variable name, got %q", tokens[0].line, tokens[0].text)
+		}
+		m.variable(tokens[0].text)
+		coefficients[tokens[0].text] += coefficient
+		tokens = tokens[1:]
+	}
+	return tokens, nil
+}
+
+func lpNumberValue(t token) (float64, error) {
+	if strings.HasPrefix(strings.ToLower(t.text), "inf") {
+		return math.Inf(1), nil
+	}
+	value, err := strconv.ParseFloat(t.text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("line %d: invalid number %q", t.line, t.text)
+	}
+	return value, nil
+}
+
+// lpLabel returns the name before a colon at the start of tokens, if any
+func lpLabel(tokens []token) (string, []token) {
+	if len(tokens) > 1 && tokens[0].kind == lpName && tokens[1].kind == lpColon {
+		return tokens[0].text, tokens[2:]
+	}
+	return "", tokens
+}
+
+func readLPObjective(m *model, tokens []token) error {
+	_, tokens = lpLabel(tokens)
+	tokens, err := readLPExpression(m, tokens, m.problem.Objective.Coefficients)
+	if err != nil {
+		return err
+	}
+	if len(tokens) > 0 {
+		return fmt.Errorf("line %d: unexpected %q in the objective", tokens[0].line, tokens[0].text)
+	}
+	return nil
+}
+
+func readLPConstraints(m *model, tokens []token) error {
+	for len(tokens) > 0 {
+		var name string
+		name, tokens = lpLabel(tokens)
+		constraint := api.Constraint{Name: name, Coefficients: make(map[string]float64)}
+		var err error
+		if tokens, err = readLPExpression(m, tokens, constraint.Coefficients); err != nil {
+			return err
+		}
+		if len(tokens) < 2 || tokens[0].kind != lpComparison {
+			return errors.New("a constraint must end with a comparison and a right hand side")
+		}
+		constraint.Type = lpComparisonType(tokens[0].text)
+		rhs := 1.0
+		tokens = tokens[1:]
+		if tokens[0].kind == lpSign {
+			if tokens[0].text == "-" {
+				rhs = -1
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 || tokens[0].kind != lpNumber {
+			return errors.New("a constraint must end with a comparison and a right hand side")
+		}
+		value, err := lpNumberValue(tokens[0])
+		if err != nil {
+			return err
+		}
+		constraint.Rhs = rhs * value
+		tokens = tokens[1:]
+		m.problem.Constraints = append(m.problem.Constraints, constraint)
+	}
+	return nil
+}
+
+func lpComparisonType(text string) string {
+	switch text {
+	case "<=", "=<", "<":
+		return "<="
+	case ">=", "=>", ">":
+		return ">="
+	}
+	return "="
+}
+
+// readLPBounds reads the bounds, one per line, each written as "x <= 4",
+// "1 <= x", "1 <= x <= 4", "x = 2" or "x free"
+func readLPBounds(m *model, tokens []token) error {
+	for len(tokens) > 0 {
+		end := 1
+		for end < len(tokens) && tokens[end].line == tokens[0].line {
+			end++
+		}
+		if err := readLPBound(m, tokens[:end]); err != nil {
+			return err
+		}
+		tokens = tokens[end:]
+	}
+	return nil
+}
+
+func readLPBound(m *model, bound []token) error {
+	line := bound[0].line
+	// the signs are folded into the numbers that follow them, the pattern of
+	// the bound is written with n for a number, c for a comparison and v for
+	// the variable
+	var pattern strings.Builder
+	var name string
+	var comparisons []string
+	var values []float64
+	for i := 0; i < len(bound); i++ {
+		t := bound[i]
+		switch {
+		case t.kind == lpSign && i+1 < len(bound) && bound[i+1].kind == lpNumber:
+			value, err := lpNumberValue(bound[i+1])
+			if err != nil {
+				return err
+			}
+			if t.text == "-" {
+				value = -value
+			}
+			values = append(values, value)
+			pattern.WriteByte('n')
+			i++
+		case t.kind == lpNumber:
+			value, err := lpNumberValue(t)
+			if err != nil {
+				return err
+			}
+			values = append(values, value)
+			pattern.WriteByte('n')
+		case t.kind == lpComparison:
+			comparisons = append(comparisons, lpComparisonType(t.text))
+			pattern.WriteByte('c')
+		case t.kind == lpName && strings.EqualFold(t.text, "free") && name != "":
+			pattern.WriteByte('f')
+		case t.kind == lpName && name == "":
+			name = t.text
+			pattern.WriteByte('v')
+		default:
+			return fmt.Errorf("line %d: invalid bound", line)
+		}
+	}
+	switch pattern.String() {
+	case "vf":
+		return fmt.Errorf("line %d: variable %q is free, which is not supported", line, name)
+	case "vcn":
+		return setLPBound(m, line, name, comparisons[0], values[0])
+	case "ncv":
+		// 1 <= x is x >= 1
+		return setLPBound(m, line, name, reverseComparison(comparisons[0]), values[0])
+	case "ncvcn":
+		if err := setLPBound(m, line, name, reverseComparison(comparisons[0]), values[0]); err != nil {
+			return err
+		}
+		return setLPBound(m, line, name, comparisons[1], values[1])
+	}
+	return fmt.Errorf("line %d: invalid bound", line)
+}
+
+func reverseComparison(constraintType string) string {
+	switch constraintType {
+	case "<=":
+		return ">="
+	case ">=":
+		return "<="
+	}
+	return constraintType
+}
+
+func setLPBound(m *model, line int, name string, constraintType string, value float64) error {
+	variable := m.variable(name)
+	switch {
+	case constraintType == "<=" && math.IsInf(value, 1):
+		variable.Upper = nil
+	case constraintType == ">=" && value == 0:
+		// the variables are already non negative
+	case value < 0:
+		return fmt.Errorf("line %d: variable %q has a negative bound, which is not supported", line, name)
+	case constraintType == "<=":
+		variable.Upper = &value
+	case constraintType == ">=":
+		variable.Lower = &value
+	default:
+		variable.Lower, variable.Upper = &value, &value
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Package modelfile reads problem files in the text format of the web page, the
+// MPS and LP formats of the other solvers and the JSON model of the API
+package modelfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"pnle/api"
+	"pnle/lp"
+	"strconv"
+	"strings"
+)
+
+// Format is the name of a model file format
+type Format string
+
+const (
+	// Text is the format of lp.ParseProblem
+	Text Format = "text"
+	// MPS is the free MPS format, whose names cannot contain spaces
+	MPS Format = "mps"
+	// LP is the CPLEX LP format
+	LP Format = "lp"
+	// JSON is the typed model of POST /api/v1/solve
+	JSON Format = "json"
+)
+
+// Formats are the formats Parse reads
+var Formats = []Format{Text, MPS, LP, JSON}
+
+// ParseFormat reads the name of a format
+func ParseFormat(name string) (Format, error) {
+	for _, format := range Formats {
+		if strings.EqualFold(name, string(format)) {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("unknown format %q, expected text, mps, lp or json", name)
+}
+
+// Detect guesses the format of a file from the extension of its name, .mps,
+// .lp or .json, or else from its content
+func Detect(filename string, content []byte) Format {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".mps":
+		return MPS
+	case ".lp":
+		return LP
+	case ".json":
+		return JSON
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("{")) {
+		return JSON
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "*") {
+			continue
+		}
+		switch strings.ToUpper(fields[0]) {
+		case "NAME", "ROWS", "OBJSENSE":
+			return MPS
+		}
+		// the objective of the text format is only made of numbers
+		if (fields[0] == "max" || fields[0] == "min") && len(fields) > 1 && allNumbers(fields[1:]) {
+			return Text
+		}
+		break
+	}
+	return LP
+}
+
+func allNumbers(fields []string) bool {
+	for _, field := range fields {
+		if _, err := strconv.ParseFloat(field, 64); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse reads a problem written in format. The problem is not validated, apart
+// from what the format itself requires
+func Parse(format Format, content []byte) (*api.Problem, error) {
+	switch format {
+	case Text:
+		problem, err := lp.ParseProblem(string(content))
+		if err != nil {
+			return nil, err
+		}
+		return api.NewProblem(problem), nil
+	case MPS:
+		return ParseMPS(content)
+	case LP:
+		return ParseLP(content)
+	case JSON:
+		var problem api.Problem
+		if err := json.Unmarshal(content, &problem); err != nil {
+			return nil, err
+		}
+		return &problem, nil
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+// model builds a problem whose variables are continuous unless declared
+// otherwise, like in the MPS and LP formats
+type model struct {
+	problem *api.Problem
+	indexes map[string]int
+}
+
+func newModel() *model {
+	return &model{
+		problem: &api.Problem{Objective: api.Objective{Sense: "min", Coefficients: make(map[string]float64)}},
+		indexes: make(map[string]int),
+	}
+}
+
+// variable returns the variable of a name, adding it the first time
+func (m *model) variable(name string) *api.Variable {
+	i, ok := m.indexes[name]
+	if !ok {
+		i = len(m.problem.Variables)
+		m.indexes[name] = i
+		m.problem.Variables = append(m.problem.Variables, api.Variable{Name: name, Type: api.VariableContinuous})
+	}
+	return &m.problem.Variables[i]
+}
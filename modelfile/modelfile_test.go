@@ -0,0 +1,152 @@
+package modelfile
+
+import (
+	"context"
+	"pnle/api"
+	"pnle/lp"
+	"reflect"
+	"testing"
+)
+
+const testMPS = `NAME          sample
+* a comment
+OBJSENSE
+    MAX
+ROWS
+ N  obj
+ L  capacity
+ G  demand
+COLUMNS
+    MARKER    'MARKER'    'INTORG'
+    x    obj    5    capacity    1
+    x    demand    1
+    MARKER    'MARKER'    'INTEND'
+    y    obj    4    capacity    1
+RHS
+    rhs    capacity    5    demand    2
+BOUNDS
+ UP bnd    y    3
+ENDATA
+`
+
+const testLP = `\ a comment
+Maximize
+ obj: 5 x + 4 y
+Subject To
+ capacity: x + y <= 5
+ demand: x >= 2
+Bounds
+ y <= 3
+Generals
+ x
+End
+`
+
+func float(value float64) *float64 {
+	return &value
+}
+
+// sampleProblem is the problem of testMPS and testLP
+func sampleProblem() *api.Problem {
+	return &api.Problem{
+		Variables: []api.Variable{
+			{Name: "x", Type: api.VariableInteger},
+			{Name: "y", Type: api.VariableContinuous, Upper: float(3)},
+		},
+		Objective: api.Objective{Sense: "max", Coefficients: map[string]float64{"x": 5, "y": 4}},
+		Constraints: []api.Constraint{
+			{Name: "capacity", Coefficients: map[string]float64{"x": 1, "y": 1}, Type: "<=", Rhs: 5},
+			{Name: "demand", Coefficients: map[string]float64{"x": 1}, Type: ">=", Rhs: 2},
+		},
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		filename string
+		content  string
+		want     Format
+	}{
+		{"model.mps", "", MPS},
+		{"model.LP", "", LP},
+		{"model.json", "", JSON},
+		{"", `{"variables": []}`, JSON},
+		{"", testMPS, MPS},
+		{"", "max 5 4\n1 1 <= 5", Text},
+		{"model.txt", "min 1 2\n1 1 >= 1", Text},
+		{"", testLP, LP},
+		{"", "max: x + y\nst\nx <= 1", LP},
+	}
+	for _, test := range tests {
+		if got := Detect(test.filename, []byte(test.content)); got != test.want {
+			t.Errorf("Detect(%q, %q) = %s, want %s", test.filename, test.content, got, test.want)
+		}
+	}
+}
+
+func TestParseMPS(t *testing.T) {
+	problem, err := ParseMPS([]byte(testMPS))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sampleProblem()
+	want.Name = "sample"
+	if !reflect.DeepEqual(problem, want) {
+		t.Errorf("ParseMPS() = %+v, want %+v", problem, want)
+	}
+}
+
+func TestParseLP(t *testing.T) {
+	problem, err := ParseLP([]byte(testLP))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := sampleProblem(); !reflect.DeepEqual(problem, want) {
+		t.Errorf("ParseLP() = %+v, want %+v", problem, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		format  Format
+		content string
+	}{
+		{MPS, "ROWS\n N obj\nCOLUMNS\n x missing 1\nENDATA\n"},
+		{MPS, "ROWS\n N obj\nRANGES\nENDATA\n"},
+		{MPS, "ROWS\n N obj\n L c1\nCOLUMNS\n x obj 1 c1 1\n"},
+		{LP, "Maximize\n obj: x +\nSubject To\n c1: x <= 1\nEnd\n"},
+		{LP, "Maximize\n obj: x\nSubject To\n c1: x <= 1\nBounds\n x >= -1\nEnd\n"},
+		{LP, "Maximize\n obj: x\nSubject To\n c1: x <= 1\nBounds\n x free\nEnd\n"},
+		{Text, "max 1 2\n1 1 <="},
+		{JSON, "{"},
+	}
+	for _, test := range tests {
+		if _, err := Parse(test.format, []byte(test.content)); err == nil {
+			t.Errorf("Parse(%s, %q) succeeded, want an error", test.format, test.content)
+		}
+	}
+}
+
+func TestNegativeRhs(t *testing.T) {
+	tests := []struct {
+		format  Format
+		content string
+	}{
+		{LP, "Maximize\n obj: x + y\nSubject To\n c1: -x - y >= -4\nEnd\n"},
+		{MPS, "NAME\nOBJSENSE\n    MAX\nROWS\n N obj\n G c1\nCOLUMNS\n x obj 1 c1 -1\n y obj 1 c1 -1\nRHS\n rhs c1 -4\nENDATA\n"},
+		{Text, "max 1 1\n-1 -1 >= -4"},
+	}
+	for _, test := range tests {
+		problem, err := Parse(test.format, []byte(test.content))
+		if err != nil {
+			t.Fatalf("Parse(%s): %v", test.format, err)
+		}
+		result, err := problem.Solve(context.Background(), lp.DefaultSolveOptions())
+		if err != nil {
+			t.Fatalf("%s: %v", test.format, err)
+		}
+		if result.Status != lp.StatusOptimal || result.Objective == nil || *result.Objective != 4 {
+			t.Errorf("%s: status %s, objective %v, want optimal with 4", test.format, result.Status, result.Objective)
+		}
+	}
+}
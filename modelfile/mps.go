@@ -0,0 +1,267 @@
+package modelfile
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"pnle/api"
+	"strconv"
+	"strings"
+)
+
+// mpsRow is a row of the ROWS section
+type mpsRow struct {
+	// constraint is the index of the constraint of the row, -1 for the objective
+	constraint int
+	// free tells that the row is an N row other than the objective, it is ignored
+	free bool
+}
+
+// ParseMPS reads a problem in the free MPS format. The objective is the first N
+// row, it is minimized unless an OBJSENSE section says MAX. The columns are
+// continuous unless they are between INTORG and INTEND markers or have integer
+// bounds, and they must be non negative
+func ParseMPS(content []byte) (*api.Problem, error) {
+	m := newModel()
+	rows := make(map[string]mpsRow)
+	objective := ""
+	section := ""
+	integer := false
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		fields := strings.Fields(text)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "*") {
+			continue
+		}
+		if text[0] != ' ' && text[0] != '\t' {
+			// a section header, NAME and OBJSENSE may have their value on the same line
+			section = strings.ToUpper(fields[0])
+			switch section {
+			case "NAME":
+				if len(fields) > 1 {
+					m.problem.Name = fields[1]
+				}
+			case "OBJSENSE":
+				if len(fields) > 1 {
+					if err := setMPSSense(m, fields[1]); err != nil {
+						return nil, fmt.Errorf("line %d: %w", line, err)
+					}
+				}
+			case "ROWS", "COLUMNS", "RHS", "BOUNDS":
+			case "RANGES":
+				return nil, fmt.Errorf("line %d: RANGES are not supported", line)
+			case "ENDATA":
+				return mpsProblem(m, objective)
+			default:
+				return nil, fmt.Errorf("line %d: unknown section %q", line, fields[0])
+			}
+			continue
+		}
+		var err error
+		switch section {
+		case "OBJSENSE":
+			err = setMPSSense(m, fields[0])
+		case "ROWS":
+			err = readMPSRow(m, rows, &objective, fields)
+		case "COLUMNS":
+			err = readMPSColumn(m, rows, &integer, fields)
+		case "RHS":
+			err = readMPSRhs(m, rows, fields)
+		case "BOUNDS":
+			err = readMPSBound(m, fields)
+		default:
+			err = errors.New("data outside of a section")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, errors.New("the file does not end with ENDATA")
+}
+
+func mpsProblem(m *model, objective string) (*api.Problem, error) {
+	if objective == "" {
+		return nil, errors.New("the file has no objective row")
+	}
+	return m.problem, nil
+}
+
+func setMPSSense(m *model, sense string) error {
+	switch strings.ToUpper(sense) {
+	case "MAX", "MAXIMIZE":
+		m.problem.Objective.Sense = "max"
+	case "MIN", "MINIMIZE":
+		m.problem.Objective.Sense = "min"
+	default:
+		return fmt.Errorf("unknown objective sense %q", sense)
+	}
+	return nil
+}
+
+func readMPSRow(m *model, rows map[string]mpsRow, objective *string, fields []string) error {
+	if len(fields) != 2 {
+		return errors.New("a row is a type and a name")
+	}
+	name := fields[1]
+	if _, ok := rows[name]; ok {
+		return fmt.Errorf("row %q is declared twice", name)
+	}
+	constraintType := ""
+	switch strings.ToUpper(fields[0]) {
+	case "N":
+		if *objective != "" {
+			rows[name] = mpsRow{constraint: -1, free: true}
+			return nil
+		}
+		*objective = name
+		rows[name] = mpsRow{constraint: -1}
+		return nil
+	case "L":
+		constraintType = "<="
+	case "G":
+		constraintType = ">="
+	case "E":
+		constraintType = "="
+	default:
+		return fmt.Errorf("unknown row type %q", fields[0])
+	}
+	rows[name] = mpsRow{constraint: len(m.problem.Constraints)}
+	m.problem.Constraints = append(m.problem.Constraints, api.Constraint{
+		Name:         name,
+		Coefficients: make(map[string]float64),
+		Type:         constraintType,
+	})
+	return nil
+}
+
+func readMPSColumn(m *model, rows map[string]mpsRow, integer *bool, fields []string) error {
+	if len(fields) == 3 && strings.Trim(fields[1], "'") == "MARKER" {
+		switch strings.Trim(fields[2], "'") {
+		case "INTORG":
+			*integer = true
+		case "INTEND":
+			*integer = false
+		default:
+			return fmt.Errorf("unknown marker %s", fields[2])
+		}
+		return nil
+	}
+	if len(fields) != 3 && len(fields) != 5 {
+		return errors.New("a column line is a column name and one or two row names and values")
+	}
+	name := fields[0]
+	if *integer {
+		m.variable(name).Type = api.VariableInteger
+	} else {
+		m.variable(name)
+	}
+	for i := 1; i < len(fields); i += 2 {
+		row, ok := rows[fields[i]]
+		if !ok {
+			return fmt.Errorf("unknown row %q", fields[i])
+		}
+		value, err := strconv.ParseFloat(fields[i+1], 64)
+		if err != nil {
+			return fmt.Errorf("invalid value %q", fields[i+1])
+		}
+		switch {
+		case row.free:
+		case row.constraint < 0:
+			m.problem.Objective.Coefficients[name] += value
+		default:
+			m.problem.Constraints[row.constraint].Coefficients[name] += value
+		}
+	}
+	return nil
+}
+
+func readMPSRhs(m *model, rows map[string]mpsRow, fields []string) error {
+	// the name of the right hand side vector is optional
+	if len(fields)%2 == 1 {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return errors.New("a right hand side line is one or two row names and values")
+	}
+	for i := 0; i < len(fields); i += 2 {
+		row, ok := rows[fields[i]]
+		if !ok {
+			return fmt.Errorf("unknown row %q", fields[i])
+		}
+		value, err := strconv.ParseFloat(fields[i+1], 64)
+		if err != nil {
+			return fmt.Errorf("invalid value %q", fields[i+1])
+		}
+		switch {
+		case row.free:
+		case row.constraint < 0:
+			if value != 0 {
+				return errors.New("a constant in the objective is not supported")
+			}
+		default:
+			m.problem.Constraints[row.constraint].Rhs = value
+		}
+	}
+	return nil
+}
+
+func readMPSBound(m *model, fields []string) error {
+	boundType := strings.ToUpper(fields[0])
+	// the bound name is optional
+	var name, valueText string
+	switch boundType {
+	case "FR", "MI", "PL", "BV":
+		name = fields[len(fields)-1]
+		if len(fields) == 4 {
+			name = fields[2]
+		}
+	default:
+		switch len(fields) {
+		case 3:
+			name, valueText = fields[1], fields[2]
+		case 4:
+			name, valueText = fields[2], fields[3]
+		default:
+			return errors.New("a bound line is a type, a bound name, a column name and a value")
+		}
+	}
+	if _, ok := m.indexes[name]; !ok {
+		return fmt.Errorf("unknown column %q", name)
+	}
+	variable := m.variable(name)
+	switch boundType {
+	case "BV":
+		variable.Type = api.VariableBinary
+		return nil
+	case "PL":
+		return nil
+	case "MI", "FR":
+		return fmt.Errorf("column %q is not non negative, which is not supported", name)
+	}
+	value, err := strconv.ParseFloat(valueText, 64)
+	if err != nil {
+		return fmt.Errorf("invalid value %q", valueText)
+	}
+	if value < 0 {
+		return fmt.Errorf("column %q has a negative bound, which is not supported", name)
+	}
+	switch boundType {
+	case "UP", "UI":
+		variable.Upper = &value
+	case "LO", "LI":
+		variable.Lower = &value
+	case "FX":
+		variable.Lower, variable.Upper = &value, &value
+	default:
+		return fmt.Errorf("unknown bound type %q", fields[0])
+	}
+	if boundType == "UI" || boundType == "LI" {
+		variable.Type = api.VariableInteger
+	}
+	return nil
+}
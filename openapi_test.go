@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"pnle/api"
+	"pnle/client"
+	"pnle/history"
+	"pnle/internal/transport"
+	"pnle/jobs"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newTestServer runs the router of serve, ready to solve and keeping the history in a temporary
+// file, and returns a client of it
+func newTestServer(t *testing.T) *client.Client {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	c := defaultConfig()
+	c.RateLimit = 0
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store, err := history.Open(filepath.Join(t.TempDir(), "history.db"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+	jobManager := jobs.NewManager(func(ctx context.Context, problem *api.Problem) (*api.Result, error) {
+		return problem.Solve(ctx, c.solveOptions(logger))
+	}, c.JobWorkers, c.JobQueue, c.JobTTL.Duration)
+	t.Cleanup(jobManager.Close)
+	r, err := newRouter(c, logger, store, jobManager, transport.NewSemaphore(c.MaxSolves))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+	// serve sets ready once it listens
+	ready.Store(true)
+	t.Cleanup(func() { ready.Store(false) })
+	return client.New(server.URL)
+}
+
+var pathParameter = regexp.MustCompile(`\{[^}]+\}|[:*][^/]+`)
+
+func TestOpenAPIRoutes(t *testing.T) {
+	var document struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(api.OpenAPI, &document); err != nil {
+		t.Fatal(err)
+	}
+	// the parameters are written {id} in the document and :id or *path by gin
+	var documented []string
+	for path, operations := range document.Paths {
+		for method := range operations {
+			if method != "parameters" {
+				documented = append(documented, strings.ToUpper(method)+" "+pathParameter.ReplaceAllString(path, "{}"))
+			}
+		}
+	}
+	gin.SetMode(gin.TestMode)
+	r, err := newRouter(defaultConfig(), slog.Default(), nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var routed []string
+	for _, route := range r.Routes() {
+		// gin answers HEAD for the static files on its own
+		if route.Method != http.MethodHead {
+			routed = append(routed, route.Method+" "+pathParameter.ReplaceAllString(route.Path, "{}"))
+		}
+	}
+	slices.Sort(documented)
+	slices.Sort(routed)
+	if !slices.Equal(documented, routed) {
+		t.Errorf("the paths of the OpenAPI document are\n%s\nthe routes are\n%s",
+			strings.Join(documented, "\n"), strings.Join(routed, "\n"))
+	}
+}
+
+func TestClient(t *testing.T) {
+	c := newTestServer(t)
+	ctx := context.Background()
+	if err := c.Ready(ctx); err != nil {
+		t.Fatalf("Ready() = %v", err)
+	}
+
+	response, err := c.Solve(ctx, "max 5 4\n6 4 <= 24\n1 2 <= 6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Status != client.SolveStatusOptimal || response.HistoryId == nil {
+		t.Fatalf("Solve() = %s with history ID %v, want optimal and saved", response.Status, response.HistoryId)
+	}
+	summaries, err := c.History(ctx)
+	if err != nil || len(summaries) != 1 {
+		t.Fatalf("History() = %v, %v, want the solved problem", summaries, err)
+	}
+	entry, err := c.HistoryEntry(ctx, *response.HistoryId)
+	if err != nil || entry.Response.Status != client.SolveStatusOptimal {
+		t.Errorf("HistoryEntry() = %v, %v, want the optimal response", entry, err)
+	}
+	if err := c.DeleteHistory(ctx, *response.HistoryId); err != nil {
+		t.Errorf("DeleteHistory() = %v", err)
+	}
+	var clientErr *client.Error
+	if _, err := c.HistoryEntry(ctx, *response.HistoryId); !errors.As(err, &clientErr) || clientErr.StatusCode != 404 {
+		t.Errorf("HistoryEntry() of a deleted entry error = %v, want 404", err)
+	}
+
+	document, err := c.Export(ctx, "max 5 4\n6 4 <= 24\n1 2 <= 6", "latex")
+	if err != nil || !strings.HasPrefix(string(document), "\\documentclass") {
+		t.Errorf("Export() = %.40q, %v, want a LaTeX document", document, err)
+	}
+
+	integer := client.VariableType("integer")
+	problem := &client.Problem{
+		Variables: []client.Variable{{Name: "x", Type: &integer}, {Name: "y", Type: &integer}},
+		Objective: client.Objective{Sense: client.Max, Coefficients: map[string]float64{"x": 5, "y": 4}},
+		Constraints: []client.Constraint{
+			{Coefficients: map[string]float64{"x": 6, "y": 4}, Type: client.ConstraintTypeLessThanEqual, Rhs: ptr(24.0)},
+			{Coefficients: map[string]float64{"x": 1, "y": 2}, Type: client.ConstraintTypeLessThanEqual, Rhs: ptr(6.0)},
+		},
+	}
+	result, err := c.SolveProblem(ctx, problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Objective == nil || *result.Objective != 20 || result.Values["x"] != 4 {
+		t.Errorf("SolveProblem() = %v with values %v, want 20 with x = 4", result.Objective, result.Values)
+	}
+
+	report, err := c.SolveBatch(ctx, &client.BatchRequest{Problems: []client.BatchProblem{
+		{Model: problem},
+		{ProblemString: ptr("max 1 1\n1 1 <= 3")},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Results) != 2 || report.Results[0].Name != "1" || report.Results[1].Objective == nil || *report.Results[1].Objective != 3 {
+		t.Errorf("SolveBatch() = %+v, want the results of the 2 problems", report.Results)
+	}
+
+	job, err := c.SubmitJob(ctx, problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for deadline := time.Now().Add(5 * time.Second); job.Status != client.JobStatusDone; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("job is %s after 5s", job.Status)
+		}
+		if job, err = c.Job(ctx, job.Id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if job.Result == nil || job.Result.Objective == nil || *job.Result.Objective != 20 {
+		t.Errorf("job result = %+v, want objective 20", job.Result)
+	}
+	if _, err := c.CancelJob(ctx, job.Id); !errors.As(err, &clientErr) || clientErr.StatusCode != 409 {
+		t.Errorf("CancelJob() of a finished job error = %v, want 409", err)
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,475 @@
+package main
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"pnle/api"
+	"pnle/graphical"
+	"pnle/history"
+	"pnle/internal/transport"
+	"pnle/jobs"
+	"pnle/lp"
+	"pnle/modelfile"
+	"slices"
+	"syscall"
+
+	"github.com/gin-gonic/gin"
+)
+
+// staticFiles are the files of the web page, they are embedded so the binary
+// runs from any directory
+//
+//go:embed static
+var staticFiles embed.FS
+
+// newEngine returns the gin engine of the server, it reads the address of the
+// clients from the forwarding headers only when they come from c.TrustedProxies
+func newEngine(c *config) (*gin.Engine, error) {
+	r := gin.New()
+	if err := r.SetTrustedProxies(c.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+	return r, nil
+}
+
+// serve starts the web server, it returns when the server fails or once it is
+// shut down by SIGINT or SIGTERM
+func serve(args []string) int {
+	c, err := loadConfig(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	} else if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitError
+	}
+	logger, err := newLogger(os.Stderr, c.LogLevel, c.LogFormat)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitError
+	}
+	slog.SetDefault(logger)
+	gin.SetMode(c.Mode)
+	var store *history.Store
+	if c.History != "" {
+		if store, err = history.Open(c.History, c.HistorySize); err != nil {
+			slog.Error("cannot open the history", "error", err)
+			return exitError
+		}
+		defer store.Close()
+	}
+	jobManager := jobs.NewManager(func(ctx context.Context, problem *api.Problem) (*api.Result, error) {
+		options := c.solveOptions(logger)
+		options.TimeLimit = c.JobTimeLimit.Duration
+		return problem.Solve(ctx, options)
+	}, c.JobWorkers, c.JobQueue, c.JobTTL.Duration)
+	defer jobManager.Close()
+	registerJobMetrics(jobManager)
+
+	// the HTTP and gRPC servers count their solves against the same limit
+	solves := transport.NewSemaphore(c.MaxSolves)
+	r, err := newRouter(c, logger, store, jobManager, solves)
+	if err != nil {
+		slog.Error("cannot create the server", "error", err)
+		return exitError
+	}
+	listener, err := net.Listen("tcp", c.Addr)
+	if err != nil {
+		slog.Error("cannot listen", "error", err)
+		return exitError
+	}
+	var grpcServer *grpcServer
+	if c.GRPCAddr != "" {
+		if grpcServer, err = newGRPCServer(c, logger, solves); err != nil {
+			slog.Error("cannot start the gRPC server", "error", err)
+			return exitError
+		}
+	}
+	server := &http.Server{Handler: r}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	failed := make(chan error, 2)
+	go func() {
+		if c.TLSCert != "" {
+			failed <- server.ServeTLS(listener, c.TLSCert, c.TLSKey)
+		} else {
+			failed <- server.Serve(listener)
+		}
+	}()
+	if grpcServer != nil {
+		go func() {
+			failed <- grpcServer.serve()
+		}()
+	}
+	ready.Store(true)
+	select {
+	case err := <-failed:
+		slog.Error("server failed", "error", err)
+		return exitError
+	case <-ctx.Done():
+	}
+	slog.Info("shutting down")
+	ready.Store(false)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), c.ShutdownTimeout.Duration)
+	defer cancel()
+	if grpcServer != nil {
+		grpcServer.shutdown(shutdownCtx)
+	}
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("shutdown failed", "error", err)
+		return exitError
+	}
+	return exitOK
+}
+
+// newRouter routes the requests of the HTTP server of serve. The solved problems
+// are kept in store when it is not nil, the jobs are run by jobManager and the
+// solves are counted against solves
+func newRouter(c *config, logger *slog.Logger, store *history.Store, jobManager *jobs.Manager, solves *transport.Semaphore) (*gin.Engine, error) {
+	static, err := fs.Sub(staticFiles, "static")
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the embedded files: %w", err)
+	}
+	index, err := fs.ReadFile(static, "index.html")
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the embedded files: %w", err)
+	}
+
+	r, err := newEngine(c)
+	if err != nil {
+		return nil, err
+	}
+	r.Use(gin.Recovery(), logRequests(logger), limitBody(c.BodyLimit), cors(c.CORSOrigins))
+	r.StaticFS("/assets", http.FS(static))
+	r.GET("/", func(ctx *gin.Context) {
+		ctx.Data(200, "text/html; charset=utf-8", index)
+	})
+	r.GET("/healthz", healthz)
+	r.GET("/readyz", readyz)
+	r.GET("/metrics", metricsText)
+	r.GET("/openapi.json", func(ctx *gin.Context) {
+		ctx.Data(200, "application/json", api.OpenAPI)
+	})
+	limitRate := rateLimit(c.RateLimit, c.RateBurst)
+	limitSolve := limitSolves(solves)
+	r.POST("/solve", limitRate, limitSolve, func(ctx *gin.Context) {
+		problem, problemString, ok := bindProblemString(ctx, c)
+		if !ok {
+			return
+		}
+		graphicalSolution := solveGraphically(problem)
+		logger := requestLogger(ctx)
+		// the request context is canceled when the browser closes the connection
+		solution, err := problem.SolveContext(ctx.Request.Context(), c.solveOptions(logger))
+		if err != nil {
+			logger.Warn("solve abandoned", "error", err)
+			return
+		}
+		response := solveResponse(c, problem, solution, graphicalSolution)
+		saveHistory(logger, store, problemString, problem, response)
+		ctx.JSON(200, response)
+	})
+	r.POST("/solve/stream", limitRate, limitSolve, solveStream(c, store))
+	r.POST("/solve/export", limitRate, limitSolve, solveExport(c))
+	r.GET("/api/history", func(ctx *gin.Context) {
+		if store == nil {
+			ctx.JSON(200, []history.Summary{})
+			return
+		}
+		list, err := store.List()
+		if err != nil {
+			ctx.JSON(500, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(200, list)
+	})
+	r.GET("/api/history/:id", func(ctx *gin.Context) {
+		if store == nil {
+			ctx.JSON(404, gin.H{"error": history.ErrNotFound.Error()})
+			return
+		}
+		entry, err := store.Get(ctx.Param("id"))
+		switch {
+		case errors.Is(err, history.ErrNotFound):
+			ctx.JSON(404, gin.H{"error": err.Error()})
+			return
+		case err != nil:
+			ctx.JSON(500, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(200, entry)
+	})
+	r.DELETE("/api/history/:id", func(ctx *gin.Context) {
+		if store == nil {
+			ctx.JSON(404, gin.H{"error": history.ErrNotFound.Error()})
+			return
+		}
+		err := store.Delete(ctx.Param("id"))
+		switch {
+		case errors.Is(err, history.ErrNotFound):
+			ctx.JSON(404, gin.H{"error": err.Error()})
+		case err != nil:
+			ctx.JSON(500, gin.H{"error": err.Error()})
+		default:
+			ctx.Status(204)
+		}
+	})
+	r.POST("/api/v1/solve", limitRate, limitSolve, solveV1(c))
+	r.POST("/api/v1/batch", limitRate, solveBatch(c, solves))
+	r.POST("/api/jobs", limitRate, func(ctx *gin.Context) {
+		problem, ok := bindProblem(ctx, c)
+		if !ok {
+			return
+		}
+		job, err := jobManager.Submit(problem)
+		if errors.Is(err, jobs.ErrQueueFull) {
+			ctx.JSON(503, gin.H{"error": err.Error()})
+			return
+		} else if err != nil {
+			ctx.JSON(500, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.Header("Location", "/api/jobs/"+job.ID)
+		ctx.JSON(202, job)
+	})
+	r.GET("/api/jobs/:id", func(ctx *gin.Context) {
+		job, err := jobManager.Get(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(404, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(200, job)
+	})
+	r.DELETE("/api/jobs/:id", func(ctx *gin.Context) {
+		job, err := jobManager.Cancel(ctx.Param("id"))
+		switch {
+		case errors.Is(err, jobs.ErrNotFound):
+			ctx.JSON(404, gin.H{"error": err.Error()})
+		case errors.Is(err, jobs.ErrFinished):
+			ctx.JSON(409, gin.H{"error": err.Error(), "job": job})
+		default:
+			ctx.JSON(200, job)
+		}
+	})
+	return r, nil
+}
+
+// cors lets the pages of origins call the server, * allows any origin
+func cors(origins []string) gin.HandlerFunc {
+	allowed := make(map[string]bool, len(origins))
+	for _, origin := range origins {
+		allowed[origin] = true
+	}
+	return func(ctx *gin.Context) {
+		origin := ctx.GetHeader("Origin")
+		if origin == "" || !(allowed["*"] || allowed[origin]) {
+			ctx.Next()
+			return
+		}
+		ctx.Header("Access-Control-Allow-Origin", origin)
+		ctx.Header("Vary", "Origin")
+		if ctx.Request.Method == http.MethodOptions {
+			ctx.Header("Access-Control-Allow-Methods", "GET, POST, DELETE")
+			ctx.Header("Access-Control-Allow-Headers", "Content-Type")
+			ctx.Header("Access-Control-Max-Age", "600")
+			ctx.AbortWithStatus(204)
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// bindProblemString reads a problem in the text format, or a model file when
+// the request is an upload, it answers 400 when the body has no problem and 413
+// when the problem is over the limits of c
+func bindProblemString(ctx *gin.Context, c *config) (*lp.IntegerLineaProblem, string, bool) {
+	if isUpload(ctx) {
+		problem, format, content, ok := bindUpload(ctx, c)
+		if !ok {
+			return nil, "", false
+		}
+		if format == modelfile.Text {
+			// keep the problem exactly as the text form would have sent it
+			integerProblem, err := lp.ParseIntegerLinearProblem(string(content))
+			if err != nil {
+				ctx.JSON(400, gin.H{"error": err.Error()})
+				return nil, "", false
+			}
+			return integerProblem, string(content), true
+		}
+		integerProblem := problem.IntegerProblem()
+		return integerProblem, integerProblem.InitialProblem.Text(), true
+	}
+	var requestBody api.TextProblem
+	if err := ctx.Copy().ShouldBindJSON(&requestBody); err != nil {
+		if bodyTooLarge(ctx, err) {
+			return nil, "", false
+		}
+		parseErrors.Inc("text")
+		ctx.JSON(400, gin.H{
+			"error": "Required parameter problemString not found",
+		})
+		return nil, "", false
+	}
+	problem, err := lp.ParseIntegerLinearProblem(requestBody.ProblemString)
+	if err != nil {
+		parseErrors.Inc("text")
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return nil, "", false
+	}
+	initial := problem.InitialProblem
+	if !checkProblemSize(ctx, c, len(initial.ObjectiveFunction), len(initial.Constraints)) {
+		return nil, "", false
+	}
+	return problem, requestBody.ProblemString, true
+}
+
+// saveHistory adds a solved problem to store, when there is one, and sets the
+// HistoryID of response to its entry. A failure to save does not fail the solve
+func saveHistory(logger *slog.Logger, store *history.Store, problemString string, problem *lp.IntegerLineaProblem, response *api.SolveResponse) {
+	if store == nil {
+		return
+	}
+	content, err := json.Marshal(response)
+	if err != nil {
+		logger.Error("history not saved", "error", err)
+		return
+	}
+	entry := history.Entry{Problem: problemString, Status: string(problem.Status), Response: content}
+	if problem.HasSolution {
+		objective := problem.OptimalObjectiveFunctionValue
+		entry.Objective = &objective
+	}
+	entry, err = store.Add(entry)
+	if err != nil {
+		logger.Error("history not saved", "error", err)
+		return
+	}
+	response.HistoryID = entry.ID
+}
+
+// solveGraphically solves two variable problems with the graphical method, it
+// must be called before the branch and bound adds its bound constraints
+func solveGraphically(problem *lp.IntegerLineaProblem) *api.Graphical {
+	if len(problem.InitialProblem.ObjectiveFunction) != 2 {
+		return nil
+	}
+	plot, err := graphical.Solve(&problem.InitialProblem)
+	if err != nil {
+		return nil
+	}
+	return &api.Graphical{Solution: plot, SVG: plot.SVG()}
+}
+
+// solveResponse is the body answered by /solve once the search is over
+func solveResponse(c *config, problem *lp.IntegerLineaProblem, solution *lp.LinearProblem, graphicalSolution *api.Graphical) *api.SolveResponse {
+	nodeTableaux, truncated := limitNodeTableaux(problem.NodeTableaux, c.MaxNodeTableaux, problem.IncumbentNode)
+	response := &api.SolveResponse{
+		Status:        problem.Status,
+		DualBound:     problem.DualBound,
+		AbsoluteGap:   problem.AbsoluteGap,
+		RelativeGap:   problem.RelativeGap,
+		Nodes:         problem.Nodes,
+		Tree:          problem.Tree,
+		TreeDot:       problem.Tree.DOT(),
+		NodeTableaux:  nodeTableaux,
+		IncumbentNode: problem.IncumbentNode,
+		Graphical:     graphicalSolution,
+	}
+	response.NodeTableauxTruncated = truncated
+	if solution == nil {
+		response.Error = "No integer solution was found"
+		if problem.Status == lp.StatusUnbounded {
+			response.Error = "The problem is unbounded"
+		}
+		return response
+	}
+	response.SolutionProblemString = solution.CreateMarkdownExpression()
+	response.SolutionString = solution.CreateSolutionMarkdownExpression()
+	if html, err := problem.ProblemHTML(); err == nil {
+		response.ProblemHTML = html
+	}
+	if html, err := problem.SolutionHTML(); err == nil {
+		response.SolutionHTML = html
+	}
+	response.Tableaux = solution.SolutionSteps
+	response.IncumbentSource = problem.IncumbentSource
+	return response
+}
+
+// limitNodeTableaux keeps the tableaux of the limit nodes with the lowest IDs,
+// the incumbent node always being one of them, and tells whether some were
+// left out. A limit of 0 keeps all of them
+func limitNodeTableaux(nodeTableaux map[int][]*lp.SimplexTableau, limit int, incumbentNode int) (map[int][]*lp.SimplexTableau, bool) {
+	if limit <= 0 || len(nodeTableaux) <= limit {
+		return nodeTableaux, false
+	}
+	ids := make([]int, 0, len(nodeTableaux))
+	for id := range nodeTableaux {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	ids = ids[:limit]
+	if _, ok := nodeTableaux[incumbentNode]; ok && !slices.Contains(ids, incumbentNode) {
+		ids[limit-1] = incumbentNode
+	}
+	limited := make(map[int][]*lp.SimplexTableau, limit)
+	for _, id := range ids {
+		limited[id] = nodeTableaux[id]
+	}
+	return limited, true
+}
+
+// solveV1 solves a problem of the typed JSON model
+func solveV1(c *config) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		problem, ok := bindProblem(ctx, c)
+		if !ok {
+			return
+		}
+		logger := requestLogger(ctx)
+		result, err := problem.Solve(ctx.Request.Context(), c.solveOptions(logger))
+		if err != nil {
+			logger.Warn("solve abandoned", "error", err)
+			return
+		}
+		ctx.JSON(200, result)
+	}
+}
+
+// bindProblem reads a problem of the typed JSON model, or a model file when the
+// request is an upload, it answers 400 when the problem is not valid and 413
+// when it is over the limits of c
+func bindProblem(ctx *gin.Context, c *config) (*api.Problem, bool) {
+	if isUpload(ctx) {
+		problem, _, _, ok := bindUpload(ctx, c)
+		return problem, ok
+	}
+	var problem api.Problem
+	if err := ctx.ShouldBindJSON(&problem); err != nil {
+		if bodyTooLarge(ctx, err) {
+			return nil, false
+		}
+		parseErrors.Inc("json")
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	if err := problem.Validate(); err != nil {
+		parseErrors.Inc("json")
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	if !checkProblemSize(ctx, c, len(problem.Variables), len(problem.Constraints)) {
+		return nil, false
+	}
+	return &problem, true
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"pnle/lp"
+	"slices"
+	"testing"
+)
+
+func TestLimitNodeTableaux(t *testing.T) {
+	nodeTableaux := map[int][]*lp.SimplexTableau{}
+	for id := 0; id < 5; id++ {
+		nodeTableaux[id] = []*lp.SimplexTableau{{Iteration: int32(id)}}
+	}
+	tests := []struct {
+		limit     int
+		incumbent int
+		ids       []int
+		truncated bool
+	}{
+		{0, 4, []int{0, 1, 2, 3, 4}, false},
+		{5, 4, []int{0, 1, 2, 3, 4}, false},
+		{3, 1, []int{0, 1, 2}, true},
+		{3, 4, []int{0, 1, 4}, true},
+		{1, 3, []int{3}, true},
+	}
+	for _, test := range tests {
+		limited, truncated := limitNodeTableaux(nodeTableaux, test.limit, test.incumbent)
+		var ids []int
+		for id := range limited {
+			ids = append(ids, id)
+		}
+		slices.Sort(ids)
+		if !slices.Equal(ids, test.ids) || truncated != test.truncated {
+			t.Errorf("limitNodeTableaux(%d, %d) = %v, %v, want %v, %v", test.limit, test.incumbent, ids, truncated, test.ids, test.truncated)
+		}
+	}
+}
+
+func TestSolveResponseNodeTableaux(t *testing.T) {
+	c := defaultConfig()
+	problem, err := lp.ParseIntegerLinearProblem("max 5 4\n6 4 <= 24\n1 2 <= 6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	solution := problem.Solve()
+	if len(problem.NodeTableaux) <= 2 {
+		t.Fatalf("the search has %d nodes, the test needs more than 2", len(problem.NodeTableaux))
+	}
+
+	response := solveResponse(c, problem, solution, nil)
+	if len(response.NodeTableaux) != len(problem.NodeTableaux) || response.NodeTableauxTruncated {
+		t.Errorf("response has the tableaux of %d nodes, truncated %v, want all %d", len(response.NodeTableaux), response.NodeTableauxTruncated, len(problem.NodeTableaux))
+	}
+	c.MaxNodeTableaux = 2
+	response = solveResponse(c, problem, solution, nil)
+	if len(response.NodeTableaux) != 2 || !response.NodeTableauxTruncated {
+		t.Errorf("response has the tableaux of %d nodes, truncated %v, want 2 and truncated", len(response.NodeTableaux), response.NodeTableauxTruncated)
+	}
+	if _, ok := response.NodeTableaux[response.IncumbentNode]; !ok {
+		t.Errorf("the tableaux of the incumbent node %d were left out", response.IncumbentNode)
+	}
+}
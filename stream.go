@@ -0,0 +1,54 @@
+package main
+
+import (
+	"io"
+	"pnle/api"
+	"pnle/history"
+	"pnle/internal/transport"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+// solveStream solves a problem in the text format like /solve, streaming the
+// solver events as they happen and the body of /solve as the last "result" event
+func solveStream(c *config, store *history.Store) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		problem, problemString, ok := bindProblemString(ctx, c)
+		if !ok {
+			return
+		}
+		graphicalSolution := solveGraphically(problem)
+		observer := transport.NewObserver(ctx.Request.Context())
+		logger := requestLogger(ctx)
+		options := c.solveOptions(logger)
+		options.Observers = append(options.Observers, observer)
+
+		var result *api.SolveResponse
+		go func() {
+			defer observer.Close()
+			solution, err := problem.SolveContext(ctx.Request.Context(), options)
+			if err != nil {
+				logger.Warn("solve abandoned", "error", err)
+				return
+			}
+			result = solveResponse(c, problem, solution, graphicalSolution)
+			saveHistory(logger, store, problemString, problem, result)
+		}()
+
+		ctx.Stream(func(w io.Writer) bool {
+			event, ok := <-observer.Events()
+			if !ok {
+				// the events channel is closed once result is set
+				if result != nil {
+					ctx.Render(-1, sse.Event{Event: "result", Data: result})
+				}
+				return false
+			}
+			ctx.Render(-1, sse.Event{Event: event.Name, Data: event.Data})
+			return true
+		})
+		// let the solver goroutine return when the client left before the end
+		observer.Drain()
+	}
+}
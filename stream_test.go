@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"pnle/api"
+	"pnle/internal/transport"
+	"pnle/lp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// streamRecorder records a stream, gin needs a CloseNotify to stream to it
+type streamRecorder struct {
+	*httptest.ResponseRecorder
+}
+
+func (streamRecorder) CloseNotify() <-chan bool {
+	return nil
+}
+
+type sseEvent struct {
+	name string
+	data string
+}
+
+// postStream posts problemString to /solve/stream with ctx and returns the
+// events of the response
+func postStream(t *testing.T, ctx context.Context, problemString string) []sseEvent {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/solve/stream", solveStream(defaultConfig(), nil))
+	body, _ := json.Marshal(api.TextProblem{ProblemString: problemString})
+	request := httptest.NewRequest(http.MethodPost, "/solve/stream", strings.NewReader(string(body))).WithContext(ctx)
+	request.Header.Set("Content-Type", "application/json")
+	recorder := streamRecorder{httptest.NewRecorder()}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.ServeHTTP(recorder, request)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the stream did not end")
+	}
+
+	var events []sseEvent
+	var event sseEvent
+	scanner := bufio.NewScanner(recorder.Body)
+	scanner.Buffer(nil, 1<<24)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event.name = strings.TrimPrefix(line, "event:")
+		case strings.HasPrefix(line, "data:"):
+			event.data += strings.TrimPrefix(line, "data:")
+		case line == "" && event.name != "":
+			events = append(events, event)
+			event = sseEvent{}
+		}
+	}
+	return events
+}
+
+func TestSolveStream(t *testing.T) {
+	events := postStream(t, context.Background(), "max 5 4\n6 4 <= 24\n1 2 <= 6")
+	if len(events) == 0 || events[len(events)-1].name != "result" {
+		t.Fatalf("the stream has %d events, want the result last", len(events))
+	}
+	var result api.SolveResponse
+	if err := json.Unmarshal([]byte(events[len(events)-1].data), &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != lp.StatusOptimal {
+		t.Errorf("result status = %s, want optimal", result.Status)
+	}
+
+	// a node is created, then started, then branched or pruned, unless it is
+	// pruned by bound before it is started
+	const (
+		created = iota + 1
+		started
+		ended
+	)
+	nodes := map[int]int{}
+	incumbents := 0
+	var last float64
+	for i, event := range events[:len(events)-1] {
+		switch event.name {
+		case "node":
+			var node transport.Node
+			if err := json.Unmarshal([]byte(event.data), &node); err != nil {
+				t.Fatal(err)
+			}
+			if i == 0 && node.Status != "created" {
+				t.Errorf("first event is node %d %s, want the root created", node.ID, node.Status)
+			}
+			state, previous := ended, nodes[node.ID]
+			switch node.Status {
+			case "created":
+				state = created
+			case "started":
+				state = started
+			}
+			if state <= previous || (state == created) != (previous == 0) || (state == started && previous != created) {
+				t.Errorf("node %d is %s after state %d", node.ID, node.Status, previous)
+			}
+			nodes[node.ID] = state
+		case "incumbent":
+			var incumbent transport.Incumbent
+			if err := json.Unmarshal([]byte(event.data), &incumbent); err != nil {
+				t.Fatal(err)
+			}
+			if incumbents > 0 && incumbent.Value < last {
+				t.Errorf("incumbent %v found after the better %v", incumbent.Value, last)
+			}
+			incumbents, last = incumbents+1, incumbent.Value
+		case "pivot", "gap":
+		default:
+			t.Errorf("unexpected %q event before the result", event.name)
+		}
+	}
+	if incumbents == 0 || last != 20 {
+		t.Errorf("%d incumbents, the last one %v, want 20 last", incumbents, last)
+	}
+	for id, state := range nodes {
+		if state != ended {
+			t.Errorf("node %d did not end, its state is %d", id, state)
+		}
+	}
+}
+
+func TestSolveStreamCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// the handler returns once the solve is abandoned, without a result
+	for _, event := range postStream(t, ctx, "max 5 4\n6 4 <= 24\n1 2 <= 6") {
+		if event.name == "result" {
+			t.Errorf("a canceled solve sent its result %s", event.data)
+		}
+	}
+}
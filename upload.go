@@ -0,0 +1,75 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"pnle/api"
+	"pnle/modelfile"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// isUpload tells whether a request sends a model file as multipart/form-data
+// rather than a JSON body
+func isUpload(ctx *gin.Context) bool {
+	return ctx.ContentType() == gin.MIMEMultipartPOSTForm
+}
+
+// bindUpload reads the model file of the "file" field of a multipart/form-data
+// request, written in the format of the "format" field or else in the format
+// detected from the file. It answers 400 when the file is missing or not valid
+// and 413 when the problem is over the limits of c
+func bindUpload(ctx *gin.Context, c *config) (*api.Problem, modelfile.Format, []byte, bool) {
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		if bodyTooLarge(ctx, err) {
+			return nil, "", nil, false
+		}
+		ctx.JSON(400, gin.H{"error": "Required file not found"})
+		return nil, "", nil, false
+	}
+	file, err := header.Open()
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return nil, "", nil, false
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return nil, "", nil, false
+	}
+	format := modelfile.Detect(header.Filename, content)
+	if name := ctx.PostForm("format"); name != "" {
+		if format, err = modelfile.ParseFormat(name); err != nil {
+			ctx.JSON(400, gin.H{"error": err.Error()})
+			return nil, "", nil, false
+		}
+	}
+	problem, err := modelfile.Parse(format, content)
+	if err == nil {
+		err = validateProblem(problem)
+	}
+	if err != nil {
+		parseErrors.Inc(string(format))
+		ctx.JSON(400, gin.H{"error": err.Error(), "format": format})
+		return nil, "", nil, false
+	}
+	if !checkProblemSize(ctx, c, len(problem.Variables), len(problem.Constraints)) {
+		return nil, "", nil, false
+	}
+	return problem, format, content, true
+}
+
+// validateProblem checks a problem that was not read by the JSON binding, with
+// its binding tags and then with Validate
+func validateProblem(problem *api.Problem) error {
+	if problem == nil {
+		return errors.New("the file has no problem")
+	}
+	if err := binding.Validator.ValidateStruct(problem); err != nil {
+		return err
+	}
+	return problem.Validate()
+}
@@ -43,3 +43,8 @@ func (q *Queue[T]) IsEmpty() bool {
 func (q *Queue[T]) Size() int {
 	return len(q.items)
 }
+
+// Items returns the items of the queue from front to back, the returned slice must not be modified
+func (q *Queue[T]) Items() []T {
+	return q.items
+}